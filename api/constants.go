@@ -57,7 +57,7 @@ var (
 		RegionVietnam,
 	}
 
-	// RegionToRoute maps each region to its route
+	// RegionToRoute maps each region to its route. Use Region.Route to also resolve regions which are routes.
 	RegionToRoute = map[Region]Route{
 		RegionBrasil:            RouteAmericas,
 		RegionEuropeNorthEast:   RouteEurope,
@@ -77,3 +77,12 @@ var (
 		RegionVietnam:           RouteSEA,
 	}
 )
+
+// Route returns the route of the region. A region which already is a route is returned as is, so clients
+// bound to a route can call the endpoints using routes.
+func (r Region) Route() Route {
+	if route, ok := RegionToRoute[r]; ok {
+		return route
+	}
+	return Route(r)
+}
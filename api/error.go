@@ -1,7 +1,10 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 )
 
 // Error is a custom error type used by the API to signal http error responses
@@ -14,6 +17,55 @@ func (e Error) Error() string {
 	return e.Message
 }
 
+// ErrorStatus is the body of an error response of the Riot API
+type ErrorStatus struct {
+	Message    string `json:"message"`
+	StatusCode int    `json:"status_code"`
+}
+
+// ResponseError describes an error response of the Riot API. It wraps the Error for the status code, so
+// errors.Is(err, api.ErrNotFound) reports whether a request failed because the resource was not found.
+type ResponseError struct {
+	// Err is the error for the status code of the response, e.g. ErrNotFound
+	Err Error
+	// Method is the HTTP method of the request
+	Method string
+	// Endpoint is the path of the request including its query
+	Endpoint string
+	// Region is the region or route the request was sent to
+	Region Region
+	// Status is the parsed body of the response. It is empty if the body could not be parsed.
+	Status ErrorStatus
+	// Body is the raw body of the response
+	Body []byte
+	// RateLimitType is the type of rate limit which was exceeded (application, method or service) as reported by
+	// the X-Rate-Limit-Type header
+	RateLimitType string
+	// RetryAfter is the duration to wait before retrying as reported by the Retry-After header
+	RetryAfter time.Duration
+}
+
+func (e *ResponseError) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Err.Message)
+	fmt.Fprintf(&b, ": %s %s (%s)", e.Method, e.Endpoint, e.Region)
+	if e.Status.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Status.Message)
+	}
+	if e.RateLimitType != "" {
+		fmt.Fprintf(&b, ", %s rate limit", e.RateLimitType)
+	}
+	if e.RetryAfter > 0 {
+		fmt.Fprintf(&b, ", retry after %v", e.RetryAfter)
+	}
+	return b.String()
+}
+
+// Unwrap returns the error for the status code of the response
+func (e *ResponseError) Unwrap() error {
+	return e.Err
+}
+
 // All regularly returned errors by the Riot API
 var (
 	ErrBadRequest = Error{
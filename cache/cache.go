@@ -0,0 +1,92 @@
+// Package cache provides a cache for responses of the Riot API. How long a response is cached depends on the
+// endpoint it was returned from, e.g. matches never change while league entries are outdated within seconds.
+package cache
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+)
+
+// Forever is the TTL of responses which never expire
+const Forever = time.Duration(math.MaxInt64)
+
+// TTLs maps endpoint templates (e.g. "/lol/match/v5/matches/%s") or prefixes of them (e.g. "/lol/league/") to
+// the duration responses of the endpoints are cached for. The longest matching prefix is used and responses
+// of endpoints without a matching prefix are not cached.
+type TTLs map[string]time.Duration
+
+// DefaultTTLs returns TTLs caching matches forever, slowly changing data like summoners for minutes and
+// frequently changing data like league entries and active games for seconds
+func DefaultTTLs() TTLs {
+	return TTLs{
+		"/lol/match/v5/matches/%s":            Forever,
+		"/lol/match/v5/matches/by-puuid/":     time.Minute,
+		"/val/match/v1/matches/%s":            Forever,
+		"/riot/account/":                      time.Hour,
+		"/lol/summoner/":                      10 * time.Minute,
+		"/lol/champion-mastery/":              10 * time.Minute,
+		"/lol/challenges/":                    10 * time.Minute,
+		"/lol/platform/v3/champion-rotations": time.Hour,
+		"/lol/league/":                        30 * time.Second,
+		"/lol/spectator/":                     10 * time.Second,
+		"/lol/status/":                        time.Minute,
+		"/val/content/":                       time.Hour,
+		"/val/ranked/":                        time.Minute,
+		"/val/status/":                        time.Minute,
+		"/lor/ranked/":                        time.Minute,
+	}
+}
+
+// TTL returns how long responses of the endpoint template are cached. Zero is returned if they are not cached.
+func (t TTLs) TTL(method string) time.Duration {
+	var ttl time.Duration
+	longest := -1
+	for prefix, d := range t {
+		if len(prefix) > longest && strings.HasPrefix(method, prefix) {
+			ttl, longest = d, len(prefix)
+		}
+	}
+	return ttl
+}
+
+// Cache caches response bodies in a store for the duration given by its TTLs.
+// Errors of the store are ignored, causing the request to be sent.
+type Cache struct {
+	store Store
+	ttls  TTLs
+}
+
+// New returns a new cache keeping response bodies in the given store for the given TTLs
+func New(store Store, ttls TTLs) *Cache {
+	return &Cache{
+		store: store,
+		ttls:  ttls,
+	}
+}
+
+// Get returns the cached body of the request to the endpoint built from the method sent to the region
+func (c *Cache) Get(ctx context.Context, region, method, endpoint string) ([]byte, bool) {
+	if c.ttls.TTL(method) <= 0 {
+		return nil, false
+	}
+	body, ok, err := c.store.Get(ctx, key(region, endpoint))
+	if err != nil {
+		return nil, false
+	}
+	return body, ok
+}
+
+// Set caches the body of a successful request to the endpoint built from the method sent to the region
+func (c *Cache) Set(ctx context.Context, region, method, endpoint string, body []byte) {
+	ttl := c.ttls.TTL(method)
+	if ttl <= 0 {
+		return
+	}
+	_ = c.store.Set(ctx, key(region, endpoint), body, ttl)
+}
+
+func key(region, endpoint string) string {
+	return region + endpoint
+}
@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTLs_TTL(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		want   time.Duration
+	}{
+		{
+			name:   "match",
+			method: "/lol/match/v5/matches/%s",
+			want:   Forever,
+		},
+		{
+			name:   "timeline",
+			method: "/lol/match/v5/matches/%s/timeline",
+			want:   Forever,
+		},
+		{
+			name:   "match ids",
+			method: "/lol/match/v5/matches/by-puuid/%s/ids",
+			want:   time.Minute,
+		},
+		{
+			name:   "league",
+			method: "/lol/league/v4/entries/%s/%s/%s",
+			want:   30 * time.Second,
+		},
+		{
+			name:   "not cached",
+			method: "/lol/tournament/v5/codes/%s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, DefaultTTLs().TTL(tt.method))
+			},
+		)
+	}
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	c := New(NewLRU(10), DefaultTTLs())
+	c.Set(ctx, "euw1", "/lol/summoner/v4/summoners/by-puuid/%s", "/lol/summoner/v4/summoners/by-puuid/a", []byte("a"))
+	c.Set(ctx, "euw1", "/lol/tournament/v5/codes/%s", "/lol/tournament/v5/codes/a", []byte("a"))
+	body, ok := c.Get(ctx, "euw1", "/lol/summoner/v4/summoners/by-puuid/%s", "/lol/summoner/v4/summoners/by-puuid/a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("a"), body)
+	_, ok = c.Get(ctx, "kr", "/lol/summoner/v4/summoners/by-puuid/%s", "/lol/summoner/v4/summoners/by-puuid/a")
+	assert.False(t, ok, "regions are cached separately")
+	_, ok = c.Get(ctx, "euw1", "/lol/tournament/v5/codes/%s", "/lol/tournament/v5/codes/a")
+	assert.False(t, ok)
+}
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// Disk is a Store keeping every value in a file in a directory. Values survive restarts of the process and
+// the directory may be shared by several processes. Expired values are removed when they are read.
+type Disk struct {
+	dir string
+	now func() time.Time
+}
+
+// NewDisk returns a new store keeping its values in the given directory, creating it if necessary
+func NewDisk(dir string) (*Disk, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &Disk{
+		dir: dir,
+		now: time.Now,
+	}, nil
+}
+
+// Get implements the Store interface
+func (d *Disk) Get(_ context.Context, key string) ([]byte, bool, error) {
+	path := d.path(key)
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if len(content) < 8 {
+		return nil, false, errors.New("corrupt cache file " + path)
+	}
+	expires := int64(binary.BigEndian.Uint64(content))
+	if expires != 0 && d.now().UnixNano() >= expires {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, false, err
+		}
+		return nil, false, nil
+	}
+	return content[8:], true, nil
+}
+
+// Set implements the Store interface. Concurrent readers never see a partially written value.
+func (d *Disk) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	var expires int64
+	if t := expiry(d.now(), ttl); !t.IsZero() {
+		expires = t.UnixNano()
+	}
+	content := make([]byte, 8+len(value))
+	binary.BigEndian.PutUint64(content, uint64(expires))
+	copy(content[8:], value)
+	return internal.WriteFileAtomic(d.path(key), content)
+}
+
+func (d *Disk) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// Store stores values by key until they expire
+type Store interface {
+	// Get returns the value stored for the key if it exists and has not expired
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores the value for the key for the given duration. A duration of Forever never expires.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// LRU is a Store keeping a limited number of values in memory, evicting the least recently used value
+// once the capacity is reached. An LRU is safe for concurrent use.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewLRU returns a new LRU store holding at most capacity values
+func NewLRU(capacity int) *LRU {
+	return &LRU{
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Get implements the Store interface
+func (c *LRU) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := element.Value.(*lruEntry)
+	if !entry.expires.IsZero() && !c.now().Before(entry.expires) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false, nil
+	}
+	c.order.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+// Set implements the Store interface
+func (c *LRU) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := &lruEntry{
+		key:     key,
+		value:   value,
+		expires: expiry(c.now(), ttl),
+	}
+	if element, ok := c.entries[key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return nil
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.capacity > 0 && c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+	return nil
+}
+
+// Len returns the number of values currently stored, including expired values which were not yet evicted
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// expiry returns the time a value stored now for the given duration expires or the zero time if it never expires
+func expiry(now time.Time, ttl time.Duration) time.Time {
+	if ttl == Forever {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
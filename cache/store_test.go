@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(0, 0)
+	c := NewLRU(2)
+	c.now = func() time.Time {
+		return now
+	}
+	get := func(key string) (string, bool) {
+		value, ok, err := c.Get(ctx, key)
+		require.Nil(t, err)
+		return string(value), ok
+	}
+	require.Nil(t, c.Set(ctx, "a", []byte("1"), time.Second))
+	require.Nil(t, c.Set(ctx, "b", []byte("2"), Forever))
+	value, ok := get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "1", value)
+	require.Nil(t, c.Set(ctx, "c", []byte("3"), Forever))
+	_, ok = get("b")
+	assert.False(t, ok, "least recently used value is evicted")
+	assert.Equal(t, 2, c.Len())
+	now = now.Add(time.Second)
+	_, ok = get("a")
+	assert.False(t, ok, "value is expired")
+	now = now.Add(24 * time.Hour)
+	value, ok = get("c")
+	assert.True(t, ok)
+	assert.Equal(t, "3", value)
+}
+
+func TestDisk(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(0, 0)
+	d, err := NewDisk(t.TempDir())
+	require.Nil(t, err)
+	d.now = func() time.Time {
+		return now
+	}
+	require.Nil(t, d.Set(ctx, "a", []byte("1"), time.Second))
+	require.Nil(t, d.Set(ctx, "b", []byte("2"), Forever))
+	value, ok, err := d.Get(ctx, "a")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+	_, ok, err = d.Get(ctx, "missing")
+	require.Nil(t, err)
+	assert.False(t, ok)
+	now = now.Add(time.Second)
+	_, ok, err = d.Get(ctx, "a")
+	require.Nil(t, err)
+	assert.False(t, ok)
+	value, ok, err = d.Get(ctx, "b")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("2"), value)
+}
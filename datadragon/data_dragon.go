@@ -11,10 +11,9 @@ import (
 	"sync"
 	"sync/atomic"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 const (
@@ -67,10 +66,10 @@ type Client struct {
 }
 
 // NewClient returns a new client for the Data Dragon service.
-func NewClient(client internal.Doer, region api.Region, logger log.FieldLogger) *Client {
+func NewClient(client internal.Doer, region api.Region, logger log.Logger) *Client {
 	c := &Client{
 		client:          client,
-		logger:          logger.WithField("client", "data dragon"),
+		logger:          log.Wrap(logger).WithField("client", "data dragon"),
 		championsByName: map[string]ChampionDataExtended{},
 	}
 	if err := c.init(regionToRealmRegion[region]); err != nil {
@@ -4,44 +4,54 @@
 // Example:
 //
 //	client := golio.NewClient("API KEY",
-//	              golio.WithRegion(api.RegionNorthAmerica),
-//	              golio.WithLogger(logrus.New().WithField("foo", "bar")))
-//	summoner, _ := client.Riot.Summoner.GetByName("SK Jenax")
-//	fmt.Printf("%s is a level %d summoner\n", summoner.Name, summoner.SummonerLevel)
+//	              golio.WithRegion(api.RegionKorea),
+//	              golio.WithLogger(logruslog.New(logrus.New().WithField("foo", "bar"))))
+//	player, err := client.Riot.ResolveRiotID("Hide on bush#KR1")
+//	if err != nil {
+//	fmt.Printf("could not find player: %v\n", err)
+//	return
+//	}
+//	name := player.Account.RiotID()
+//	fmt.Printf("%s is a level %d summoner\n", name, player.Summoner.SummonerLevel)
 //	champion, _ := client.DataDragon.GetChampion("Ashe")
-//	mastery, err := client.Riot.ChampionMastery.Get(summoner.ID, champion.Key)
+//	mastery, err := client.Riot.LoL.ChampionMastery.GetByPUUID(player.Account.Puuid, champion.Key)
 //	if err != nil {
-//	fmt.Printf("%s has not played any games on %s\n", summoner.Name, champion.Name)
+//	fmt.Printf("%s has not played any games on %s\n", name, champion.Name)
 //	} else {
-//	fmt.Printf("%s has mastery level %d with %d points on %s\n", summoner.Name, mastery.ChampionLevel,
+//	fmt.Printf("%s has mastery level %d with %d points on %s\n", name, mastery.ChampionLevel,
 //	mastery.ChampionPoints, champion.Name)
 //	}
-//	challengers, _ := client.Riot.League.GetChallenger(api.QueueRankedSolo)
+//	challengers, _ := client.Riot.LoL.League.GetChallenger(lol.QueueRankedSolo)
 //	rank1 := challengers.GetRank(0)
-//	fmt.Printf("%s is the highest ranked player with %d league points\n", rank1.SummonerName, rank1.LeaguePoints)
+//	fmt.Printf("%s is the highest ranked player with %d league points\n", rank1.PUUID, rank1.LeaguePoints)
 package golio
 
 import (
 	"net/http"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/datadragon"
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
+	"github.com/KnutZuidema/golio/observe"
+	"github.com/KnutZuidema/golio/retry"
 	"github.com/KnutZuidema/golio/riot"
 	"github.com/KnutZuidema/golio/static"
 )
 
 // Client is a client for both the Riot API and the Data Dragon service
 type Client struct {
-	client     internal.Doer
-	logger     log.FieldLogger
-	region     api.Region
-	apiKey     string
-	Riot       *riot.Client
-	DataDragon *datadragon.Client
-	Static     *static.Client
+	client      internal.Doer
+	logger      log.Logger
+	region      api.Region
+	apiKey      string
+	rateLimiter internal.RateLimiter
+	retryPolicy *retry.Policy
+	cache       internal.Cache
+	hook        observe.Hook
+	Riot        *riot.Client
+	DataDragon  *datadragon.Client
+	Static      *static.Client
 }
 
 // Option is used to alter the attributes of a client
@@ -54,8 +64,10 @@ func WithClient(c internal.Doer) Option {
 	}
 }
 
-// WithLogger sets the given logger for the golio client
-func WithLogger(l log.FieldLogger) Option {
+// WithLogger sets the given logger for the golio client. Loggers which do not implement log.FieldLogger get
+// their fields appended to the messages, so logrus loggers should be passed as logruslog.New(l) to keep their
+// fields structured. Adapters for other loggers are provided by the log package. log.Default() is used by default.
+func WithLogger(l log.Logger) Option {
 	return func(client *Client) {
 		client.logger = l
 	}
@@ -68,18 +80,53 @@ func WithRegion(r api.Region) Option {
 	}
 }
 
+// WithRateLimiter sets the given rate limiter for requests to the Riot API (e.g. ratelimit.NewLimiter())
+func WithRateLimiter(l internal.RateLimiter) Option {
+	return func(client *Client) {
+		client.rateLimiter = l
+	}
+}
+
+// WithRetryPolicy sets the given retry policy for requests to the Riot API. retry.Legacy() is used by default.
+func WithRetryPolicy(p *retry.Policy) Option {
+	return func(client *Client) {
+		client.retryPolicy = p
+	}
+}
+
+// WithCache sets the given cache for responses of the Riot API, e.g.
+// cache.New(cache.NewLRU(1000), cache.DefaultTTLs()). Nothing is cached by default.
+func WithCache(c internal.Cache) Option {
+	return func(client *Client) {
+		client.cache = c
+	}
+}
+
+// WithHook sets the given hook observing every request to the Riot API, e.g. observe.NewMetrics().
+// Several hooks can be combined with observe.Hooks.
+func WithHook(h observe.Hook) Option {
+	return func(client *Client) {
+		client.hook = h
+	}
+}
+
 // NewClient returns a new client for both the Riot API and the Data Dragon service
 func NewClient(apiKey string, options ...Option) *Client {
 	c := &Client{
 		client: http.DefaultClient,
-		logger: log.StandardLogger(),
+		logger: log.Default(),
 		region: api.RegionEuropeWest,
 		apiKey: apiKey,
 	}
 	for _, opt := range options {
 		opt(c)
 	}
-	c.Riot = riot.NewClient(c.region, c.apiKey, c.client, c.logger)
+	base := internal.NewClient(c.region, c.apiKey, c.client, c.logger)
+	base.RateLimiter = c.rateLimiter
+	base.RetryPolicy = c.retryPolicy
+	base.Cache = c.cache
+	base.Hook = c.hook
+	c.Riot = riot.NewClientWithBase(base)
 	c.DataDragon = datadragon.NewClient(c.client, c.region, c.logger)
 	c.Static = static.NewClient(c.client, c.logger)
 	return c
@@ -5,9 +5,17 @@ import (
 	"testing"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/cache"
+	goliolog "github.com/KnutZuidema/golio/log"
+	"github.com/KnutZuidema/golio/log/logruslog"
+	"github.com/KnutZuidema/golio/observe"
+	"github.com/KnutZuidema/golio/ratelimit"
+	"github.com/KnutZuidema/golio/retry"
 )
 
 func TestNewClient(t *testing.T) {
@@ -16,6 +24,22 @@ func TestNewClient(t *testing.T) {
 		WithLogger(log.StandardLogger()),
 		WithRegion(api.RegionEuropeWest),
 		WithClient(http.DefaultClient),
+		WithRateLimiter(ratelimit.NewLimiter()),
+		WithRetryPolicy(retry.Default()),
+		WithCache(cache.New(cache.NewLRU(100), cache.DefaultTTLs())),
+		WithHook(observe.NewMetrics()),
 	)
 	require.NotNil(t, client)
 }
+
+func TestWithLogger(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	client := &Client{}
+	WithLogger(logruslog.New(logger))(client)
+	fieldLogger, ok := client.logger.(goliolog.FieldLogger)
+	require.True(t, ok)
+	fieldLogger.WithField("method", "Get").Info("message")
+	require.Len(t, hook.Entries, 1)
+	assert.Equal(t, "message", hook.LastEntry().Message)
+	assert.Equal(t, log.Fields{"method": "Get"}, hook.LastEntry().Data)
+}
@@ -0,0 +1,17 @@
+package internal
+
+import "context"
+
+type bearerTokenContextKey struct{}
+
+// WithBearerToken returns a copy of the context authorizing requests with the given access token in addition
+// to the API key, e.g. an access token obtained via Riot Sign On. Responses to such requests are never cached.
+func WithBearerToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, bearerTokenContextKey{}, token)
+}
+
+// bearerTokenFromContext returns the access token stored in the context
+func bearerTokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(bearerTokenContextKey{}).(string)
+	return token, ok
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+)
+
+// Cache is an interface for any cache storing the response bodies of GET requests to the Riot API.
+// Failures of the cache are not reported, a request is simply sent if no cached body can be returned.
+type Cache interface {
+	// Get returns the cached body of the request to the endpoint built from the method sent to the region
+	Get(ctx context.Context, region, method, endpoint string) ([]byte, bool)
+	// Set caches the body of a successful request to the endpoint built from the method sent to the region
+	Set(ctx context.Context, region, method, endpoint string, body []byte)
+}
+
+// cacheable reports whether the response to the request may be cached. Only GET requests which are not
+// authorized by an access token are cached, since the response may differ per token.
+func (c *Client) cacheable(ctx context.Context, method string) bool {
+	if c.Cache == nil || method != http.MethodGet {
+		return false
+	}
+	_, ok := bearerTokenFromContext(ctx)
+	return !ok
+}
+
+// cachedResponse returns a response with the cached body of the GET request to the endpoint if there is one
+func (c *Client) cachedResponse(ctx context.Context, method, endpoint string) (*http.Response, bool) {
+	if !c.cacheable(ctx, method) {
+		return nil, false
+	}
+	body, ok := c.Cache.Get(ctx, string(c.Region), EndpointFromContext(ctx, endpoint), endpoint)
+	if !ok {
+		return nil, false
+	}
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}, true
+}
+
+// cacheResponse caches the body of the successful GET request to the endpoint and replaces the consumed body
+// of the response with the read bytes
+func (c *Client) cacheResponse(ctx context.Context, method, endpoint string, response *http.Response) error {
+	if !c.cacheable(ctx, method) || response.Body == nil {
+		return nil
+	}
+	body, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	c.Cache.Set(ctx, string(c.Region), EndpointFromContext(ctx, endpoint), endpoint, body)
+	return nil
+}
@@ -2,16 +2,17 @@ package internal
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"strconv"
 	"time"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/log"
+	"github.com/KnutZuidema/golio/observe"
+	"github.com/KnutZuidema/golio/retry"
 )
 
 const (
@@ -21,33 +22,57 @@ const (
 	apiTokenHeaderKey = "X-Riot-Token"
 )
 
+var defaultRetryPolicy = retry.Legacy()
+
 // Client provides methods for communication with the Riot API.
 type Client struct {
 	L      log.FieldLogger
 	Region api.Region
 	APIKey string
 	Client Doer
+	// RateLimiter delays requests to stay within the rate limits. No limiting is done if it is nil.
+	RateLimiter RateLimiter
+	// RetryPolicy decides which failed requests are retried. retry.Legacy() is used if it is nil.
+	RetryPolicy *retry.Policy
+	// Cache stores the response bodies of GET requests. Nothing is cached if it is nil.
+	Cache Cache
+	// Hook observes every request passed to the Doer. Nothing is observed if it is nil.
+	Hook observe.Hook
 }
 
 // NewClient returns a new client.
-func NewClient(region api.Region, key string, client Doer, logger log.FieldLogger) *Client {
+func NewClient(region api.Region, key string, client Doer, logger log.Logger) *Client {
 	return &Client{
-		L:      logger,
+		L:      log.Wrap(logger),
 		Region: region,
 		APIKey: key,
 		Client: client,
 	}
 }
 
+// WithRegion returns a copy of the client sending requests to the given region or route. The copy shares the
+// Doer, rate limiter, cache and hook of the client.
+func (c *Client) WithRegion(region api.Region) *Client {
+	clone := *c
+	clone.Region = region
+	return &clone
+}
+
 // GetInto processes a GET request and saves the response body into the given target.
 func (c *Client) GetInto(endpoint string, target interface{}) error {
+	return c.GetIntoWithContext(context.Background(), endpoint, target)
+}
+
+// GetIntoWithContext processes a GET request bound to the given context and saves the response body
+// into the given target.
+func (c *Client) GetIntoWithContext(ctx context.Context, endpoint string, target interface{}) error {
 	logger := c.Logger().WithFields(
 		log.Fields{
 			"method":   "GetInto",
 			"endpoint": endpoint,
 		},
 	)
-	response, err := c.Get(endpoint)
+	response, err := c.GetWithContext(ctx, endpoint)
 	if err != nil {
 		logger.Debug(err)
 		return err
@@ -61,13 +86,19 @@ func (c *Client) GetInto(endpoint string, target interface{}) error {
 
 // PostInto processes a POST request and saves the response body into the given target.
 func (c *Client) PostInto(endpoint string, body, target interface{}) error {
+	return c.PostIntoWithContext(context.Background(), endpoint, body, target)
+}
+
+// PostIntoWithContext processes a POST request bound to the given context and saves the response body
+// into the given target.
+func (c *Client) PostIntoWithContext(ctx context.Context, endpoint string, body, target interface{}) error {
 	logger := c.Logger().WithFields(
 		log.Fields{
 			"method":   "PostInto",
 			"endpoint": endpoint,
 		},
 	)
-	response, err := c.Post(endpoint, body)
+	response, err := c.PostWithContext(ctx, endpoint, body)
 	if err != nil {
 		logger.Debug(err)
 		return err
@@ -81,6 +112,11 @@ func (c *Client) PostInto(endpoint string, body, target interface{}) error {
 
 // Put processes a PUT request.
 func (c *Client) Put(endpoint string, body interface{}) error {
+	return c.PutWithContext(context.Background(), endpoint, body)
+}
+
+// PutWithContext processes a PUT request bound to the given context.
+func (c *Client) PutWithContext(ctx context.Context, endpoint string, body interface{}) error {
 	logger := c.Logger().WithFields(
 		log.Fields{
 			"method":   "Put",
@@ -92,17 +128,27 @@ func (c *Client) Put(endpoint string, body interface{}) error {
 		logger.Debug(err)
 		return err
 	}
-	_, err := c.DoRequest("PUT", endpoint, buf)
+	_, err := c.DoRequestWithContext(ctx, "PUT", endpoint, buf)
 	return err
 }
 
 // Get processes a GET request.
 func (c *Client) Get(endpoint string) (*http.Response, error) {
-	return c.DoRequest("GET", endpoint, nil)
+	return c.GetWithContext(context.Background(), endpoint)
+}
+
+// GetWithContext processes a GET request bound to the given context.
+func (c *Client) GetWithContext(ctx context.Context, endpoint string) (*http.Response, error) {
+	return c.DoRequestWithContext(ctx, "GET", endpoint, nil)
 }
 
 // Post processes a POST request.
 func (c *Client) Post(endpoint string, body interface{}) (*http.Response, error) {
+	return c.PostWithContext(context.Background(), endpoint, body)
+}
+
+// PostWithContext processes a POST request bound to the given context.
+func (c *Client) PostWithContext(ctx context.Context, endpoint string, body interface{}) (*http.Response, error) {
 	logger := c.Logger().WithFields(
 		log.Fields{
 			"method":   "Post",
@@ -114,77 +160,168 @@ func (c *Client) Post(endpoint string, body interface{}) (*http.Response, error)
 		logger.Debug(err)
 		return nil, err
 	}
-	return c.DoRequest("POST", endpoint, buf)
+	return c.DoRequestWithContext(ctx, "POST", endpoint, buf)
 }
 
 // DoRequest processes a http.Request and returns the response.
-// Rate-Limiting and retrying is handled via the corresponding response headers.
+// Failed requests are retried according to the retry policy of the client.
 func (c *Client) DoRequest(method, endpoint string, body io.Reader) (*http.Response, error) {
+	return c.DoRequestWithContext(context.Background(), method, endpoint, body)
+}
+
+// DoRequestWithContext processes a http.Request bound to the given context and returns the response.
+// Cancelling the context aborts the request as well as any pending wait before a retry.
+func (c *Client) DoRequestWithContext(
+	ctx context.Context, method, endpoint string, body io.Reader,
+) (*http.Response, error) {
 	logger := c.Logger().WithFields(
 		log.Fields{
 			"method":   "DoRequest",
 			"endpoint": endpoint,
 		},
 	)
-	request, err := c.NewRequest(method, endpoint, body)
-	if err != nil {
-		logger.Debug(err)
-		return nil, err
+	var content []byte
+	if body != nil {
+		var err error
+		if content, err = io.ReadAll(body); err != nil {
+			logger.Debug(err)
+			return nil, err
+		}
 	}
-	response, err := c.Client.Do(request)
-	if err != nil {
-		logger.Debug(err)
-		return nil, err
+	if response, ok := c.cachedResponse(ctx, method, endpoint); ok {
+		logger.Debug("using cached response")
+		return response, nil
 	}
-	if response.StatusCode == http.StatusServiceUnavailable {
-		logger.Info("service unavailable, retrying")
-		time.Sleep(time.Second)
-		response, err = c.Client.Do(request)
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = defaultRetryPolicy
+	}
+	retrier := policy.NewRetrier()
+	var response *http.Response
+	for attempt := 1; ; attempt++ {
+		var requestBody io.Reader
+		if content != nil {
+			requestBody = bytes.NewReader(content)
+		}
+		request, err := c.NewRequestWithContext(ctx, method, endpoint, requestBody)
 		if err != nil {
 			logger.Debug(err)
 			return nil, err
 		}
-	}
-	if response.StatusCode == http.StatusTooManyRequests {
-		retry := response.Header.Get("Retry-After")
-		seconds, err := strconv.Atoi(retry)
+		response, err = c.do(ctx, request, endpoint, attempt)
+		delay, ok, retryErr := retrier.Next(method, response, err)
+		if retryErr != nil {
+			logger.Debug(retryErr)
+			if response != nil {
+				// the response can not be retried, e.g. a rate limited response without a Retry-After header
+				err := c.newResponseError(method, endpoint, response)
+				logger.Debug(err)
+				return nil, err
+			}
+			return nil, retryErr
+		}
+		if !ok {
+			if err != nil {
+				logger.Debug(err)
+				return nil, err
+			}
+			break
+		}
 		if err != nil {
+			logger.Infof("request failed with %v, retrying in %v", err, delay)
+		} else {
+			logger.Infof("error response %v, retrying in %v", response.Status, delay)
+			if response.Body != nil {
+				_ = response.Body.Close()
+			}
+		}
+		if err := Sleep(ctx, delay); err != nil {
 			logger.Debug(err)
 			return nil, err
 		}
-		logger.Infof("rate limited, waiting %d seconds", seconds)
-		time.Sleep(time.Duration(seconds) * time.Second)
-		return c.DoRequest(method, endpoint, body)
 	}
 	if response.StatusCode < 200 || response.StatusCode > 299 {
-		logger.Debugf("error response: %v", response.Status)
-		err, ok := api.StatusToError[response.StatusCode]
-		if !ok {
-			err = api.Error{
-				Message:    "unknown error reason",
-				StatusCode: response.StatusCode,
-			}
+		err := c.newResponseError(method, endpoint, response)
+		logger.Debug(err)
+		return nil, err
+	}
+	if err := c.cacheResponse(ctx, method, endpoint, response); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return response, nil
+}
+
+// do sends the request once the rate limiter allows it and records the rate limits of the response.
+func (c *Client) do(ctx context.Context, request *http.Request, endpoint string, attempt int) (*http.Response, error) {
+	method := EndpointFromContext(ctx, endpoint)
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(ctx, string(c.Region), method); err != nil {
+			return nil, err
 		}
+	}
+	response, err := c.send(ctx, request, method, attempt)
+	if err != nil {
 		return nil, err
 	}
+	if c.RateLimiter != nil {
+		c.RateLimiter.Update(string(c.Region), method, response.Header)
+	}
 	return response, nil
 }
 
+// send passes the request to the Doer, reporting it to the hook
+func (c *Client) send(ctx context.Context, request *http.Request, method string, attempt int) (*http.Response, error) {
+	if c.Hook == nil {
+		return c.Client.Do(request)
+	}
+	info := observe.Request{
+		Method:   request.Method,
+		Endpoint: method,
+		Region:   string(c.Region),
+		Attempt:  attempt,
+	}
+	ctx = c.Hook.Start(ctx, info)
+	start := time.Now()
+	response, err := c.Client.Do(request.WithContext(ctx))
+	result := observe.Result{
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if response != nil {
+		result.StatusCode = response.StatusCode
+	}
+	c.Hook.End(ctx, info, result)
+	return response, err
+}
+
 // NewRequest returns a new http.Request with necessary headers et.
 func (c *Client) NewRequest(method, endpoint string, body io.Reader) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, endpoint, body)
+}
+
+// NewRequestWithContext returns a new http.Request bound to the given context with necessary headers set.
+func (c *Client) NewRequestWithContext(
+	ctx context.Context, method, endpoint string, body io.Reader,
+) (*http.Request, error) {
 	logger := c.Logger().WithFields(
 		log.Fields{
 			"method":   "NewRequest",
 			"endpoint": endpoint,
 		},
 	)
-	request, err := http.NewRequest(method, fmt.Sprintf(apiURLFormat, scheme, c.Region, baseURL, endpoint), body)
+	request, err := http.NewRequestWithContext(
+		ctx, method, fmt.Sprintf(apiURLFormat, scheme, c.Region, baseURL, endpoint), body,
+	)
 	if err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
 	request.Header.Add(apiTokenHeaderKey, c.APIKey)
 	request.Header.Add("Accept", "application/json")
+	if token, ok := bearerTokenFromContext(ctx); ok {
+		request.Header.Add("Authorization", "Bearer "+token)
+	}
 	return request, nil
 }
 
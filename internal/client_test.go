@@ -1,16 +1,23 @@
 package internal
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/observe"
+	"github.com/KnutZuidema/golio/retry"
 )
 
 func TestClient_DoRequest(t *testing.T) {
@@ -100,6 +107,326 @@ func TestClient_DoRequest(t *testing.T) {
 	}
 }
 
+func TestClient_DoRequestWithContext(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    Doer
+		timeout time.Duration
+		wantErr error
+	}{
+		{
+			name:    "abort rate limit wait",
+			doer:    mock.NewRateLimitDoer(1),
+			timeout: 50 * time.Millisecond,
+			wantErr: context.DeadlineExceeded,
+		},
+		{
+			name:    "abort service unavailable wait",
+			doer:    mock.NewUnavailableOnceDoer(1),
+			timeout: 50 * time.Millisecond,
+			wantErr: context.DeadlineExceeded,
+		},
+		{
+			name:    "succeed",
+			doer:    mock.NewJSONMockDoer(1, 200),
+			timeout: time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+				defer cancel()
+				c := NewClient(api.RegionEuropeNorthEast, "", tt.doer, logrus.StandardLogger())
+				before := time.Now()
+				_, err := c.DoRequestWithContext(ctx, "GET", "endpoint", nil)
+				assert.Equal(t, tt.wantErr, err)
+				assert.Less(t, time.Since(before), time.Second)
+			},
+		)
+	}
+}
+
+func TestClient_DoRequestRetryPolicy(t *testing.T) {
+	t.Parallel()
+	attempts := 0
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			attempts++
+			body, err := io.ReadAll(r.Body)
+			if err != nil || string(body) != "body" {
+				return nil, fmt.Errorf("unexpected body %q", body)
+			}
+			if attempts < 3 {
+				return mock.NewStatusMockDoer(http.StatusInternalServerError).Do(r)
+			}
+			return mock.NewJSONMockDoer(1, 200).Do(r)
+		},
+	}
+	var retries []retry.Retry
+	c := NewClient(api.RegionEuropeWest, "", doer, logrus.StandardLogger())
+	c.RetryPolicy = &retry.Policy{
+		MaxAttempts: 3,
+		Statuses:    map[int]int{http.StatusInternalServerError: 0},
+		Methods:     []string{http.MethodPost},
+		OnRetry: func(r retry.Retry) {
+			retries = append(retries, r)
+		},
+	}
+	_, err := c.DoRequest("POST", "endpoint", strings.NewReader("body"))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Len(t, retries, 2)
+}
+
+func TestClient_DoRequestPostNotRetried(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		response func(r *http.Request) (*http.Response, error)
+	}{
+		{
+			name: "service unavailable",
+			response: func(r *http.Request) (*http.Response, error) {
+				return mock.NewStatusMockDoer(http.StatusServiceUnavailable).Do(r)
+			},
+		},
+		{
+			name: "network error",
+			response: func(r *http.Request) (*http.Response, error) {
+				return nil, fmt.Errorf("connection reset")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				attempts := 0
+				doer := &mock.Doer{
+					Custom: func(r *http.Request) (*http.Response, error) {
+						attempts++
+						return tt.response(r)
+					},
+				}
+				c := NewClient(api.RegionEuropeWest, "", doer, logrus.StandardLogger())
+				c.RetryPolicy = retry.Default()
+				_, err := c.DoRequest(http.MethodPost, "/lol/tournament/v5/codes", strings.NewReader("{}"))
+				assert.Error(t, err)
+				assert.Equal(t, 1, attempts)
+			},
+		)
+	}
+}
+
+type recordingLimiter struct {
+	waited  []string
+	updated []string
+	err     error
+}
+
+func (l *recordingLimiter) Wait(_ context.Context, region, method string) error {
+	l.waited = append(l.waited, region+method)
+	return l.err
+}
+
+func (l *recordingLimiter) Update(region, method string, _ http.Header) {
+	l.updated = append(l.updated, region+method)
+}
+
+func TestClient_DoRequestRateLimiter(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		limiter     *recordingLimiter
+		wantErr     error
+		wantUpdated []string
+	}{
+		{
+			name:        "wait and update",
+			limiter:     &recordingLimiter{},
+			wantUpdated: []string{"euw1/lol/match/v5/matches/%s"},
+		},
+		{
+			name:    "wait fails",
+			limiter: &recordingLimiter{err: context.Canceled},
+			wantErr: context.Canceled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c := NewClient(api.RegionEuropeWest, "", mock.NewJSONMockDoer(1, 200), logrus.StandardLogger())
+				c.RateLimiter = tt.limiter
+				ctx := WithEndpoint(context.Background(), "/lol/match/v5/matches/%s")
+				_, err := c.DoRequestWithContext(ctx, "GET", "/lol/match/v5/matches/EUW1_1", nil)
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, []string{"euw1/lol/match/v5/matches/%s"}, tt.limiter.waited)
+				assert.Equal(t, tt.wantUpdated, tt.limiter.updated)
+			},
+		)
+	}
+}
+
+func TestClient_DoRequestResponseError(t *testing.T) {
+	t.Parallel()
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header: http.Header{
+					"Retry-After":       []string{"3"},
+					"X-Rate-Limit-Type": []string{"method"},
+				},
+				Body: io.NopCloser(
+					strings.NewReader(`{"status":{"message":"Rate limit exceeded","status_code":429}}`),
+				),
+			}, nil
+		},
+	}
+	c := NewClient(api.RegionKorea, "", doer, logrus.StandardLogger())
+	c.RetryPolicy = &retry.Policy{}
+	_, err := c.DoRequest("GET", "/lol/summoner/v4/summoners/by-puuid/a", nil)
+	assert.ErrorIs(t, err, api.ErrRateLimitExceeded)
+	var responseErr *api.ResponseError
+	assert.True(t, errors.As(err, &responseErr))
+	assert.Equal(t, "GET", responseErr.Method)
+	assert.Equal(t, "/lol/summoner/v4/summoners/by-puuid/a", responseErr.Endpoint)
+	assert.Equal(t, api.RegionKorea, responseErr.Region)
+	assert.Equal(t, api.ErrorStatus{Message: "Rate limit exceeded", StatusCode: 429}, responseErr.Status)
+	assert.Equal(t, "method", responseErr.RateLimitType)
+	assert.Equal(t, 3*time.Second, responseErr.RetryAfter)
+	assert.Equal(
+		t,
+		"rate limit exceeded: GET /lol/summoner/v4/summoners/by-puuid/a (kr): Rate limit exceeded, method rate limit, "+
+			"retry after 3s",
+		err.Error(),
+	)
+}
+
+func TestClient_DoRequestRateLimitedWithoutRetryAfter(t *testing.T) {
+	t.Parallel()
+	body := &closeRecorder{Reader: strings.NewReader(`{"status":{"message":"Rate limit exceeded","status_code":429}}`)}
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"X-Rate-Limit-Type": []string{"service"}},
+				Body:       body,
+			}, nil
+		},
+	}
+	c := NewClient(api.RegionKorea, "", doer, logrus.StandardLogger())
+	c.RetryPolicy = retry.Legacy()
+	_, err := c.DoRequest("GET", "/lol/summoner/v4/summoners/by-puuid/a", nil)
+	require.ErrorIs(t, err, api.ErrRateLimitExceeded)
+	var responseErr *api.ResponseError
+	require.True(t, errors.As(err, &responseErr))
+	assert.Equal(t, "/lol/summoner/v4/summoners/by-puuid/a", responseErr.Endpoint)
+	assert.Equal(t, "service", responseErr.RateLimitType)
+	assert.Equal(t, api.ErrorStatus{Message: "Rate limit exceeded", StatusCode: 429}, responseErr.Status)
+	assert.True(t, body.closed)
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+type recordingHook struct {
+	requests []observe.Request
+	results  []observe.Result
+}
+
+func (h *recordingHook) Start(ctx context.Context, request observe.Request) context.Context {
+	h.requests = append(h.requests, request)
+	return ctx
+}
+
+func (h *recordingHook) End(_ context.Context, _ observe.Request, result observe.Result) {
+	result.Duration = 0
+	h.results = append(h.results, result)
+}
+
+func TestClient_DoRequestHook(t *testing.T) {
+	t.Parallel()
+	hook := &recordingHook{}
+	doer := mock.NewStatusMockDoer(http.StatusInternalServerError)
+	c := NewClient(api.RegionEuropeWest, "", doer, logrus.StandardLogger())
+	c.Hook = hook
+	c.RetryPolicy = &retry.Policy{MaxAttempts: 2, Statuses: map[int]int{http.StatusInternalServerError: 0}}
+	ctx := WithEndpoint(context.Background(), "/lol/match/v5/matches/%s")
+	_, err := c.DoRequestWithContext(ctx, "GET", "/lol/match/v5/matches/EUW1_1", nil)
+	assert.ErrorIs(t, err, api.ErrInternalServerError)
+	assert.Equal(
+		t, []observe.Request{
+			{Method: "GET", Endpoint: "/lol/match/v5/matches/%s", Region: "euw1", Attempt: 1},
+			{Method: "GET", Endpoint: "/lol/match/v5/matches/%s", Region: "euw1", Attempt: 2},
+		}, hook.requests,
+	)
+	assert.Equal(
+		t, []observe.Result{{StatusCode: http.StatusInternalServerError}, {StatusCode: http.StatusInternalServerError}},
+		hook.results,
+	)
+}
+
+type mapCache map[string][]byte
+
+func (c mapCache) Get(_ context.Context, region, _, endpoint string) ([]byte, bool) {
+	body, ok := c[region+endpoint]
+	return body, ok
+}
+
+func (c mapCache) Set(_ context.Context, region, _, endpoint string, body []byte) {
+	c[region+endpoint] = body
+}
+
+func TestClient_DoRequestCache(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`"body"`)),
+			}, nil
+		},
+	}
+	c := NewClient(api.RegionEuropeWest, "", doer, logrus.StandardLogger())
+	c.Cache = mapCache{}
+	for i := 0; i < 2; i++ {
+		var target string
+		assert.Nil(t, c.GetInto("/lol/match/v5/matches/EUW1_1", &target))
+		assert.Equal(t, "body", target)
+	}
+	assert.Equal(t, 1, calls)
+	_, err := c.Post("/lol/match/v5/matches/EUW1_1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls, "only GET requests are cached")
+	ctx := WithBearerToken(context.Background(), "token")
+	for i := 0; i < 2; i++ {
+		var target string
+		assert.Nil(t, c.GetIntoWithContext(ctx, "/riot/account/v1/accounts/me", &target))
+	}
+	assert.Equal(t, 4, calls, "requests authorized by an access token are not cached")
+}
+
+func TestClient_NewRequestWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := NewClient(api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(200), logrus.StandardLogger())
+	request, err := c.NewRequestWithContext(ctx, "GET", "/endpoint", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, ctx, request.Context())
+	assert.Equal(t, "API_KEY", request.Header.Get(apiTokenHeaderKey))
+}
+
 func TestClient_GetInto(t *testing.T) {
 	tests := []struct {
 		name    string
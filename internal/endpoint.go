@@ -0,0 +1,26 @@
+package internal
+
+import (
+	"context"
+	"strings"
+)
+
+type endpointContextKey struct{}
+
+// WithEndpoint returns a copy of the context carrying the endpoint template a request path was built from.
+// The template identifies the API method independent of the IDs filled into it.
+func WithEndpoint(ctx context.Context, template string) context.Context {
+	return context.WithValue(ctx, endpointContextKey{}, template)
+}
+
+// EndpointFromContext returns the endpoint template stored in the context. If no template is stored the given
+// endpoint is returned instead. Query parameters are always stripped.
+func EndpointFromContext(ctx context.Context, endpoint string) string {
+	if template, ok := ctx.Value(endpointContextKey{}).(string); ok {
+		endpoint = template
+	}
+	if i := strings.IndexByte(endpoint, '?'); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+	return endpoint
+}
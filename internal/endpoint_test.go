@@ -0,0 +1,43 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointFromContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		endpoint string
+		want     string
+	}{
+		{
+			name:     "no template",
+			ctx:      context.Background(),
+			endpoint: "/lol/match/v5/matches/EUW1_1",
+			want:     "/lol/match/v5/matches/EUW1_1",
+		},
+		{
+			name:     "template",
+			ctx:      WithEndpoint(context.Background(), "/lol/match/v5/matches/%s"),
+			endpoint: "/lol/match/v5/matches/EUW1_1",
+			want:     "/lol/match/v5/matches/%s",
+		},
+		{
+			name:     "strip query",
+			ctx:      WithEndpoint(context.Background(), "/lol/match/v5/matches/by-puuid/%s/ids?start=%d&count=%d"),
+			endpoint: "/lol/match/v5/matches/by-puuid/id/ids?start=0&count=100",
+			want:     "/lol/match/v5/matches/by-puuid/%s/ids",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, EndpointFromContext(tt.ctx, tt.endpoint))
+			},
+		)
+	}
+}
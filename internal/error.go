@@ -0,0 +1,49 @@
+package internal
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// newResponseError returns the error describing the error response to a request with the given method to the
+// endpoint. The body of the response is consumed and closed.
+func (c *Client) newResponseError(method, endpoint string, response *http.Response) *api.ResponseError {
+	err, ok := api.StatusToError[response.StatusCode]
+	if !ok {
+		err = api.Error{
+			Message:    "unknown error reason",
+			StatusCode: response.StatusCode,
+		}
+	}
+	responseErr := &api.ResponseError{
+		Err:           err,
+		Method:        method,
+		Endpoint:      endpoint,
+		Region:        c.Region,
+		RateLimitType: response.Header.Get("X-Rate-Limit-Type"),
+	}
+	if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil {
+		responseErr.RetryAfter = time.Duration(seconds) * time.Second
+	}
+	if response.Body == nil {
+		return responseErr
+	}
+	defer response.Body.Close()
+	body, readErr := io.ReadAll(response.Body)
+	if readErr != nil {
+		return responseErr
+	}
+	responseErr.Body = body
+	var status struct {
+		Status api.ErrorStatus `json:"status"`
+	}
+	if json.Unmarshal(body, &status) == nil {
+		responseErr.Status = status.Status
+	}
+	return responseErr
+}
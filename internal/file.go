@@ -0,0 +1,25 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// WriteFileAtomic writes data to the file at path. The data is written to a temporary file in the same directory
+// first and renamed afterwards, so readers never see a partially written file.
+func WriteFileAtomic(path string, data []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(path), "tmp-")
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return err
+	}
+	if err := file.Close(); err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
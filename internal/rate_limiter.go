@@ -0,0 +1,15 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+)
+
+// RateLimiter is an interface for any limiter which delays requests to stay within the rate limits of the
+// Riot API. The limits are learned from the rate limit headers of every response.
+type RateLimiter interface {
+	// Wait blocks until a request for the method may be sent to the region without exceeding a rate limit
+	Wait(ctx context.Context, region, method string) error
+	// Update records the rate limit headers of a response for the method sent to the region
+	Update(region, method string, header http.Header)
+}
@@ -1,6 +1,10 @@
 package internal
 
-import "sync"
+import (
+	"context"
+	"sync"
+	"time"
+)
 
 // RWLockToggle locks the given mutex for reading and returns two functions
 // the first function returned should be used to unlock the mutex
@@ -22,3 +26,16 @@ func RWLockToggle(mu *sync.RWMutex) (func(), func()) {
 			mu.Lock()
 		}
 }
+
+// Sleep waits for the given duration or until the context is done, whichever happens first.
+// The context error is returned if the context finished before the duration elapsed.
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -1,8 +1,10 @@
 package internal
 
 import (
+	"context"
 	"sync"
 	"testing"
+	"time"
 )
 
 func TestRWLockToggle(t *testing.T) {
@@ -13,3 +15,14 @@ func TestRWLockToggle(t *testing.T) {
 	toggle()
 	unlock()
 }
+
+func TestSleep(t *testing.T) {
+	if err := Sleep(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := Sleep(ctx, time.Hour); err != context.Canceled {
+		t.Errorf("want error %v, got %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,126 @@
+// Package log defines the logging interface used by golio together with adapters for common loggers.
+// Loggers which support structured fields should implement FieldLogger, otherwise fields are appended to the
+// messages. Any logrus.FieldLogger satisfies Logger, but not FieldLogger, so logrus loggers should be adapted with
+// logruslog.New to keep their fields.
+package log
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Logger is the minimal interface of a logger used by golio
+type Logger interface {
+	Debug(args ...interface{})
+	Debugf(format string, args ...interface{})
+	Info(args ...interface{})
+	Infof(format string, args ...interface{})
+	Warn(args ...interface{})
+	Warnf(format string, args ...interface{})
+	Error(args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// Fields are structured fields attached to log messages
+type Fields map[string]interface{}
+
+// FieldLogger is a Logger attaching structured fields to its messages
+type FieldLogger interface {
+	Logger
+	// WithField returns a logger attaching the field to all messages
+	WithField(key string, value interface{}) FieldLogger
+	// WithFields returns a logger attaching the fields to all messages
+	WithFields(fields Fields) FieldLogger
+}
+
+// Wrap returns a FieldLogger logging with the given logger. The logger is returned as is if it already is a
+// FieldLogger, otherwise fields are appended to the messages in the form key=value. This includes logrus
+// loggers which are not adapted with logruslog.New.
+// A nil logger discards all messages.
+func Wrap(l Logger) FieldLogger {
+	if l == nil {
+		return Nop()
+	}
+	if fieldLogger, ok := l.(FieldLogger); ok {
+		return fieldLogger
+	}
+	return &fieldLogger{logger: l}
+}
+
+// fieldLogger appends its fields to the messages of a Logger without support for fields
+type fieldLogger struct {
+	logger Logger
+	fields Fields
+}
+
+func (l *fieldLogger) WithField(key string, value interface{}) FieldLogger {
+	return l.WithFields(Fields{key: value})
+}
+
+func (l *fieldLogger) WithFields(fields Fields) FieldLogger {
+	merged := make(Fields, len(l.fields)+len(fields))
+	for key, value := range l.fields {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+	return &fieldLogger{
+		logger: l.logger,
+		fields: merged,
+	}
+}
+
+func (l *fieldLogger) Debug(args ...interface{}) {
+	l.logger.Debug(l.message(fmt.Sprint(args...)))
+}
+
+func (l *fieldLogger) Debugf(format string, args ...interface{}) {
+	l.logger.Debug(l.message(fmt.Sprintf(format, args...)))
+}
+
+func (l *fieldLogger) Info(args ...interface{}) {
+	l.logger.Info(l.message(fmt.Sprint(args...)))
+}
+
+func (l *fieldLogger) Infof(format string, args ...interface{}) {
+	l.logger.Info(l.message(fmt.Sprintf(format, args...)))
+}
+
+func (l *fieldLogger) Warn(args ...interface{}) {
+	l.logger.Warn(l.message(fmt.Sprint(args...)))
+}
+
+func (l *fieldLogger) Warnf(format string, args ...interface{}) {
+	l.logger.Warn(l.message(fmt.Sprintf(format, args...)))
+}
+
+func (l *fieldLogger) Error(args ...interface{}) {
+	l.logger.Error(l.message(fmt.Sprint(args...)))
+}
+
+func (l *fieldLogger) Errorf(format string, args ...interface{}) {
+	l.logger.Error(l.message(fmt.Sprintf(format, args...)))
+}
+
+func (l *fieldLogger) message(msg string) string {
+	if len(l.fields) == 0 {
+		return msg
+	}
+	var b strings.Builder
+	b.WriteString(msg)
+	for _, key := range sortedKeys(l.fields) {
+		fmt.Fprintf(&b, " %s=%v", key, l.fields[key])
+	}
+	return b.String()
+}
+
+func sortedKeys(fields Fields) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,57 @@
+package log
+
+import (
+	"bytes"
+	stdlog "log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrap(t *testing.T) {
+	var b bytes.Buffer
+	logger := Wrap(NewStd(stdlog.New(&b, "", 0), LevelDebug))
+	logger.WithFields(Fields{"method": "Get", "category": "match"}).WithField("region", "euw1").Debugf("%d", 404)
+	logger.Infof("plain")
+	assert.Equal(
+		t, "level=debug msg=\"404 category=match method=Get region=euw1\"\nlevel=info msg=\"plain\"\n", b.String(),
+	)
+}
+
+func TestWrap_FieldLogger(t *testing.T) {
+	logger := Nop()
+	assert.Equal(t, logger, Wrap(logger))
+	assert.Equal(t, logger, Wrap(nil))
+}
+
+func TestNewStd(t *testing.T) {
+	tests := []struct {
+		name  string
+		level Level
+		want  string
+	}{
+		{
+			name:  "debug",
+			level: LevelDebug,
+			want:  "level=debug msg=\"a\"\nlevel=info msg=\"b\"\nlevel=warn msg=\"c\"\nlevel=error msg=\"d\"\n",
+		},
+		{
+			name:  "warn",
+			level: LevelWarn,
+			want:  "level=warn msg=\"c\"\nlevel=error msg=\"d\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var b bytes.Buffer
+				logger := NewStd(stdlog.New(&b, "", 0), tt.level)
+				logger.Debug("a")
+				logger.Info("b")
+				logger.Warnf("%s", "c")
+				logger.Error("d")
+				assert.Equal(t, tt.want, b.String())
+			},
+		)
+	}
+}
@@ -0,0 +1,26 @@
+// Package logruslog adapts logrus loggers to the logging interface of golio, passing fields on to logrus.
+// Plain logrus loggers can be used with golio as well, but their fields are appended to the messages.
+package logruslog
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/log"
+)
+
+// New returns a logger logging with the given logrus logger
+func New(l logrus.FieldLogger) log.FieldLogger {
+	return &logger{FieldLogger: l}
+}
+
+type logger struct {
+	logrus.FieldLogger
+}
+
+func (l *logger) WithField(key string, value interface{}) log.FieldLogger {
+	return &logger{FieldLogger: l.FieldLogger.WithField(key, value)}
+}
+
+func (l *logger) WithFields(fields log.Fields) log.FieldLogger {
+	return &logger{FieldLogger: l.FieldLogger.WithFields(logrus.Fields(fields))}
+}
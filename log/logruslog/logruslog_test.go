@@ -0,0 +1,21 @@
+package logruslog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KnutZuidema/golio/log"
+)
+
+func TestNew(t *testing.T) {
+	var b bytes.Buffer
+	l := logrus.New()
+	l.SetOutput(&b)
+	l.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+	logger := New(l).WithFields(log.Fields{"method": "Get"}).WithField("region", "euw1")
+	logger.Infof("retrying in %v", "1s")
+	assert.Equal(t, "level=info msg=\"retrying in 1s\" method=Get region=euw1\n", b.String())
+}
@@ -0,0 +1,19 @@
+package log
+
+// Nop returns a logger discarding all messages
+func Nop() FieldLogger {
+	return nop{}
+}
+
+type nop struct{}
+
+func (n nop) WithField(string, interface{}) FieldLogger { return n }
+func (n nop) WithFields(Fields) FieldLogger             { return n }
+func (nop) Debug(...interface{})                        {}
+func (nop) Debugf(string, ...interface{})               {}
+func (nop) Info(...interface{})                         {}
+func (nop) Infof(string, ...interface{})                {}
+func (nop) Warn(...interface{})                         {}
+func (nop) Warnf(string, ...interface{})                {}
+func (nop) Error(...interface{})                        {}
+func (nop) Errorf(string, ...interface{})               {}
@@ -0,0 +1,71 @@
+//go:build go1.21
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// NewSlog returns a logger logging with the given slog logger. Fields are passed as attributes.
+func NewSlog(l *slog.Logger) FieldLogger {
+	return &slogLogger{logger: l}
+}
+
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+func (l *slogLogger) WithField(key string, value interface{}) FieldLogger {
+	return &slogLogger{logger: l.logger.With(key, value)}
+}
+
+func (l *slogLogger) WithFields(fields Fields) FieldLogger {
+	args := make([]interface{}, 0, 2*len(fields))
+	for _, key := range sortedKeys(fields) {
+		args = append(args, key, fields[key])
+	}
+	return &slogLogger{logger: l.logger.With(args...)}
+}
+
+func (l *slogLogger) Debug(args ...interface{}) {
+	l.log(slog.LevelDebug, func() string { return fmt.Sprint(args...) })
+}
+
+func (l *slogLogger) Debugf(format string, args ...interface{}) {
+	l.log(slog.LevelDebug, func() string { return fmt.Sprintf(format, args...) })
+}
+
+func (l *slogLogger) Info(args ...interface{}) {
+	l.log(slog.LevelInfo, func() string { return fmt.Sprint(args...) })
+}
+
+func (l *slogLogger) Infof(format string, args ...interface{}) {
+	l.log(slog.LevelInfo, func() string { return fmt.Sprintf(format, args...) })
+}
+
+func (l *slogLogger) Warn(args ...interface{}) {
+	l.log(slog.LevelWarn, func() string { return fmt.Sprint(args...) })
+}
+
+func (l *slogLogger) Warnf(format string, args ...interface{}) {
+	l.log(slog.LevelWarn, func() string { return fmt.Sprintf(format, args...) })
+}
+
+func (l *slogLogger) Error(args ...interface{}) {
+	l.log(slog.LevelError, func() string { return fmt.Sprint(args...) })
+}
+
+func (l *slogLogger) Errorf(format string, args ...interface{}) {
+	l.log(slog.LevelError, func() string { return fmt.Sprintf(format, args...) })
+}
+
+// log formats the message only if the level is enabled
+func (l *slogLogger) log(level slog.Level, msg func() string) {
+	ctx := context.Background()
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+	l.logger.Log(ctx, level, msg())
+}
@@ -0,0 +1,30 @@
+//go:build go1.21
+
+package log
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSlog(t *testing.T) {
+	var b bytes.Buffer
+	handler := slog.NewTextHandler(
+		&b, &slog.HandlerOptions{
+			Level: slog.LevelInfo,
+			ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.TimeKey {
+					return slog.Attr{}
+				}
+				return a
+			},
+		},
+	)
+	logger := NewSlog(slog.New(handler)).WithFields(Fields{"method": "Get", "category": "match"})
+	logger.Debug("hidden")
+	logger.WithField("region", "euw1").Infof("retrying in %v", "1s")
+	assert.Equal(t, "level=INFO msg=\"retrying in 1s\" category=match method=Get region=euw1\n", b.String())
+}
@@ -0,0 +1,90 @@
+package log
+
+import (
+	"fmt"
+	stdlog "log"
+)
+
+// Level is the severity of a log message
+type Level int
+
+// All levels in increasing severity
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	default:
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+}
+
+// Default returns the logger used by golio if no logger is configured. It writes messages of level info and
+// above with the default logger of the standard library.
+func Default() Logger {
+	return NewStd(stdlog.Default(), LevelInfo)
+}
+
+// NewStd returns a logger writing messages of at least the given level with a logger of the standard library
+func NewStd(l *stdlog.Logger, level Level) Logger {
+	return &std{
+		logger: l,
+		level:  level,
+	}
+}
+
+type std struct {
+	logger *stdlog.Logger
+	level  Level
+}
+
+func (s *std) Debug(args ...interface{}) {
+	s.log(LevelDebug, fmt.Sprint(args...))
+}
+
+func (s *std) Debugf(format string, args ...interface{}) {
+	s.log(LevelDebug, fmt.Sprintf(format, args...))
+}
+
+func (s *std) Info(args ...interface{}) {
+	s.log(LevelInfo, fmt.Sprint(args...))
+}
+
+func (s *std) Infof(format string, args ...interface{}) {
+	s.log(LevelInfo, fmt.Sprintf(format, args...))
+}
+
+func (s *std) Warn(args ...interface{}) {
+	s.log(LevelWarn, fmt.Sprint(args...))
+}
+
+func (s *std) Warnf(format string, args ...interface{}) {
+	s.log(LevelWarn, fmt.Sprintf(format, args...))
+}
+
+func (s *std) Error(args ...interface{}) {
+	s.log(LevelError, fmt.Sprint(args...))
+}
+
+func (s *std) Errorf(format string, args ...interface{}) {
+	s.log(LevelError, fmt.Sprintf(format, args...))
+}
+
+func (s *std) log(level Level, msg string) {
+	if level < s.level {
+		return
+	}
+	s.logger.Printf("level=%s msg=%q", level, msg)
+}
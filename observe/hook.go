@@ -0,0 +1,58 @@
+// Package observe provides hooks to observe the requests sent to the Riot API, e.g. to record metrics or to
+// trace requests.
+package observe
+
+import (
+	"context"
+	"time"
+)
+
+// Request describes a single attempt of sending a request to the Riot API
+type Request struct {
+	// Method is the HTTP method of the request
+	Method string
+	// Endpoint is the template of the requested endpoint without any filled in IDs,
+	// e.g. "/lol/match/v5/matches/%s"
+	Endpoint string
+	// Region is the region or route the request is sent to
+	Region string
+	// Attempt is the number of the attempt, starting with 1 and increased for every retry
+	Attempt int
+}
+
+// Result describes the outcome of an attempt
+type Result struct {
+	// StatusCode is the status code of the response. It is zero if no response was received.
+	StatusCode int
+	// Err is the error returned instead of a response, e.g. a network error
+	Err error
+	// Duration is the time it took to receive the response. Time spent waiting for the rate limiter is excluded.
+	Duration time.Duration
+}
+
+// Hook observes the requests sent to the Riot API. A Hook must be safe for concurrent use.
+type Hook interface {
+	// Start is called before a request is sent. The returned context is used for sending the request and is
+	// passed to End.
+	Start(ctx context.Context, request Request) context.Context
+	// End is called after a request was sent
+	End(ctx context.Context, request Request, result Result)
+}
+
+// Hooks combines several hooks into one. They are started in order and ended in reverse order.
+type Hooks []Hook
+
+// Start implements the Hook interface
+func (h Hooks) Start(ctx context.Context, request Request) context.Context {
+	for _, hook := range h {
+		ctx = hook.Start(ctx, request)
+	}
+	return ctx
+}
+
+// End implements the Hook interface
+func (h Hooks) End(ctx context.Context, request Request, result Result) {
+	for i := len(h) - 1; i >= 0; i-- {
+		h[i].End(ctx, request, result)
+	}
+}
@@ -0,0 +1,169 @@
+package observe
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets are the default upper bounds in seconds of the request duration histogram
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Metrics is a Hook counting requests and recording their durations per HTTP method, endpoint, region and
+// status. The metrics are exposed in the Prometheus text format, so a Metrics can be scraped directly or be
+// served next to the metrics of a Prometheus registry:
+//
+//	golio_requests_total               counter of attempts
+//	golio_request_retries_total        counter of attempts which were retries
+//	golio_request_duration_seconds     histogram of the durations of attempts
+//
+// The status label is the status code of the response or "error" if no response was received.
+// A Metrics is safe for concurrent use.
+type Metrics struct {
+	mu      sync.Mutex
+	buckets []float64
+	series  map[labels]*series
+}
+
+type labels struct {
+	method   string
+	endpoint string
+	region   string
+	status   string
+}
+
+type series struct {
+	count   int
+	retries int
+	sum     float64
+	buckets []int
+}
+
+// NewMetrics returns new metrics recording durations in the given histogram buckets. DefaultBuckets are used if
+// no buckets are given.
+func NewMetrics(buckets ...float64) *Metrics {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &Metrics{
+		buckets: buckets,
+		series:  map[labels]*series{},
+	}
+}
+
+// Start implements the Hook interface
+func (m *Metrics) Start(ctx context.Context, _ Request) context.Context {
+	return ctx
+}
+
+// End implements the Hook interface
+func (m *Metrics) End(_ context.Context, request Request, result Result) {
+	key := labels{
+		method:   request.Method,
+		endpoint: request.Endpoint,
+		region:   request.Region,
+		status:   "error",
+	}
+	if result.StatusCode != 0 {
+		key.status = strconv.Itoa(result.StatusCode)
+	}
+	seconds := result.Duration.Seconds()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.series[key]
+	if !ok {
+		s = &series{buckets: make([]int, len(m.buckets))}
+		m.series[key] = s
+	}
+	s.count++
+	if request.Attempt > 1 {
+		s.retries++
+	}
+	s.sum += seconds
+	for i, bound := range m.buckets {
+		if seconds <= bound {
+			s.buckets[i]++
+		}
+	}
+}
+
+// WriteTo writes the metrics in the Prometheus text format to the writer
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys := make([]labels, 0, len(m.series))
+	for key := range m.series {
+		keys = append(keys, key)
+	}
+	sort.Slice(
+		keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		},
+	)
+	counter := &countingWriter{w: w}
+	b := bufio.NewWriter(counter)
+	fmt.Fprint(b, "# HELP golio_requests_total Number of requests sent to the Riot API.\n")
+	fmt.Fprint(b, "# TYPE golio_requests_total counter\n")
+	for _, key := range keys {
+		fmt.Fprintf(b, "golio_requests_total{%s} %d\n", key, m.series[key].count)
+	}
+	fmt.Fprint(b, "# HELP golio_request_retries_total Number of retried requests sent to the Riot API.\n")
+	fmt.Fprint(b, "# TYPE golio_request_retries_total counter\n")
+	for _, key := range keys {
+		fmt.Fprintf(b, "golio_request_retries_total{%s} %d\n", key, m.series[key].retries)
+	}
+	fmt.Fprint(b, "# HELP golio_request_duration_seconds Duration of requests sent to the Riot API.\n")
+	fmt.Fprint(b, "# TYPE golio_request_duration_seconds histogram\n")
+	for _, key := range keys {
+		s := m.series[key]
+		for i, bound := range m.buckets {
+			fmt.Fprintf(
+				b, "golio_request_duration_seconds_bucket{%s,le=%q} %d\n", key,
+				strconv.FormatFloat(bound, 'g', -1, 64), s.buckets[i],
+			)
+		}
+		fmt.Fprintf(b, "golio_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", key, s.count)
+		fmt.Fprintf(b, "golio_request_duration_seconds_sum{%s} %s\n", key, strconv.FormatFloat(s.sum, 'g', -1, 64))
+		fmt.Fprintf(b, "golio_request_duration_seconds_count{%s} %d\n", key, s.count)
+	}
+	err := b.Flush()
+	return counter.n, err
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = m.WriteTo(w)
+}
+
+func (l labels) String() string {
+	return fmt.Sprintf(
+		`method="%s",endpoint="%s",region="%s",status="%s"`, escapeLabel(l.method), escapeLabel(l.endpoint),
+		escapeLabel(l.region), escapeLabel(l.status),
+	)
+}
+
+var labelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(value string) string {
+	return labelReplacer.Replace(value)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
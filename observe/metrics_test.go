@@ -0,0 +1,51 @@
+package observe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics(0.1, 1)
+	request := Request{
+		Method:   "GET",
+		Endpoint: "/lol/match/v5/matches/%s",
+		Region:   "europe",
+		Attempt:  1,
+	}
+	ctx := m.Start(context.Background(), request)
+	m.End(ctx, request, Result{StatusCode: 200, Duration: 50 * time.Millisecond})
+	request.Attempt = 2
+	m.End(ctx, request, Result{StatusCode: 200, Duration: 500 * time.Millisecond})
+	m.End(ctx, request, Result{Err: errors.New("timeout"), Duration: 2 * time.Second})
+	var b strings.Builder
+	n, err := m.WriteTo(&b)
+	require.Nil(t, err)
+	assert.Equal(t, int64(b.Len()), n)
+	ok := `method="GET",endpoint="/lol/match/v5/matches/%s",region="europe",status="200"`
+	failed := `method="GET",endpoint="/lol/match/v5/matches/%s",region="europe",status="error"`
+	for _, line := range []string{
+		"golio_requests_total{" + ok + "} 2",
+		"golio_requests_total{" + failed + "} 1",
+		"golio_request_retries_total{" + ok + "} 1",
+		"golio_request_duration_seconds_bucket{" + ok + `,le="0.1"} 1`,
+		"golio_request_duration_seconds_bucket{" + ok + `,le="1"} 2`,
+		"golio_request_duration_seconds_bucket{" + failed + `,le="1"} 0`,
+		"golio_request_duration_seconds_bucket{" + failed + `,le="+Inf"} 1`,
+		"golio_request_duration_seconds_sum{" + ok + "} 0.55",
+		"golio_request_duration_seconds_count{" + ok + "} 2",
+	} {
+		assert.Contains(t, b.String(), line+"\n")
+	}
+	recorder := httptest.NewRecorder()
+	m.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, b.String(), recorder.Body.String())
+}
@@ -0,0 +1,77 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+)
+
+// Tracer starts spans. It mirrors the subset of an OpenTelemetry tracer used by Tracing, so an OpenTelemetry
+// tracer can be adapted in a few lines.
+type Tracer interface {
+	// Start starts a span with the given name as a child of the span in the context and returns a context
+	// containing the new span
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer
+type Span interface {
+	// SetAttribute sets the attribute with the given key to the value
+	SetAttribute(key string, value interface{})
+	// SetError marks the span as failed with the given error
+	SetError(err error)
+	// End ends the span
+	End()
+}
+
+// Attribute keys set on the spans started by Tracing. They follow the OpenTelemetry semantic conventions
+// for HTTP clients where possible.
+const (
+	AttributeMethod      = "http.request.method"
+	AttributeStatusCode  = "http.response.status_code"
+	AttributeResendCount = "http.request.resend_count"
+	AttributeRoute       = "golio.endpoint"
+	AttributeRegion      = "golio.region"
+)
+
+// tracing stores its spans in the context under the hook itself, so nested Tracing hooks don't overwrite each
+// other's span
+type tracing struct {
+	tracer Tracer
+}
+
+// NewTracing returns a hook starting a span for every request. The spans are named after the HTTP method and
+// the endpoint template, e.g. "GET /lol/match/v5/matches/%s", and fail for error responses.
+func NewTracing(tracer Tracer) Hook {
+	return &tracing{
+		tracer: tracer,
+	}
+}
+
+// Start implements the Hook interface
+func (t *tracing) Start(ctx context.Context, request Request) context.Context {
+	ctx, span := t.tracer.Start(ctx, request.Method+" "+request.Endpoint)
+	span.SetAttribute(AttributeMethod, request.Method)
+	span.SetAttribute(AttributeRoute, request.Endpoint)
+	span.SetAttribute(AttributeRegion, request.Region)
+	if request.Attempt > 1 {
+		span.SetAttribute(AttributeResendCount, request.Attempt-1)
+	}
+	return context.WithValue(ctx, t, span)
+}
+
+// End implements the Hook interface
+func (t *tracing) End(ctx context.Context, _ Request, result Result) {
+	span, ok := ctx.Value(t).(Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+	if result.Err != nil {
+		span.SetError(result.Err)
+		return
+	}
+	span.SetAttribute(AttributeStatusCode, result.StatusCode)
+	if result.StatusCode >= 400 {
+		span.SetError(fmt.Errorf("error response with status %d", result.StatusCode))
+	}
+}
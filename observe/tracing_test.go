@@ -0,0 +1,119 @@
+package observe
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingTracer struct {
+	spans []*recordingSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &recordingSpan{name: name, attributes: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+type recordingSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (s *recordingSpan) SetAttribute(key string, value interface{}) {
+	s.attributes[key] = value
+}
+
+func (s *recordingSpan) SetError(err error) {
+	s.err = err
+}
+
+func (s *recordingSpan) End() {
+	s.ended = true
+}
+
+func TestTracing(t *testing.T) {
+	tests := []struct {
+		name           string
+		attempt        int
+		result         Result
+		wantAttributes map[string]interface{}
+		wantErr        bool
+	}{
+		{
+			name:    "success",
+			attempt: 1,
+			result:  Result{StatusCode: 200},
+			wantAttributes: map[string]interface{}{
+				AttributeMethod:     "GET",
+				AttributeRoute:      "/lol/summoner/v4/summoners/by-puuid/%s",
+				AttributeRegion:     "euw1",
+				AttributeStatusCode: 200,
+			},
+		},
+		{
+			name:    "error response",
+			attempt: 2,
+			result:  Result{StatusCode: 503},
+			wantAttributes: map[string]interface{}{
+				AttributeMethod:      "GET",
+				AttributeRoute:       "/lol/summoner/v4/summoners/by-puuid/%s",
+				AttributeRegion:      "euw1",
+				AttributeStatusCode:  503,
+				AttributeResendCount: 1,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "network error",
+			attempt: 1,
+			result:  Result{Err: errors.New("timeout")},
+			wantAttributes: map[string]interface{}{
+				AttributeMethod: "GET",
+				AttributeRoute:  "/lol/summoner/v4/summoners/by-puuid/%s",
+				AttributeRegion: "euw1",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tracer := &recordingTracer{}
+				hook := Hooks{NewTracing(tracer), NewMetrics()}
+				request := Request{
+					Method:   "GET",
+					Endpoint: "/lol/summoner/v4/summoners/by-puuid/%s",
+					Region:   "euw1",
+					Attempt:  tt.attempt,
+				}
+				ctx := hook.Start(context.Background(), request)
+				hook.End(ctx, request, tt.result)
+				assert.Len(t, tracer.spans, 1)
+				span := tracer.spans[0]
+				assert.Equal(t, "GET /lol/summoner/v4/summoners/by-puuid/%s", span.name)
+				assert.Equal(t, tt.wantAttributes, span.attributes)
+				assert.Equal(t, tt.wantErr, span.err != nil)
+				assert.True(t, span.ended)
+			},
+		)
+	}
+}
+
+func TestTracing_Nested(t *testing.T) {
+	outer, inner := &recordingTracer{}, &recordingTracer{}
+	hook := Hooks{NewTracing(outer), NewTracing(inner)}
+	request := Request{Method: "GET", Endpoint: "/lol/status/v4/platform-data", Region: "euw1", Attempt: 1}
+	ctx := hook.Start(context.Background(), request)
+	hook.End(ctx, request, Result{StatusCode: 200})
+	for _, tracer := range []*recordingTracer{outer, inner} {
+		assert.Len(t, tracer.spans, 1)
+		assert.True(t, tracer.spans[0].ended)
+		assert.Equal(t, 200, tracer.spans[0].attributes[AttributeStatusCode])
+	}
+}
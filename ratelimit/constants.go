@@ -0,0 +1,21 @@
+package ratelimit
+
+// Response headers used by the Riot API to communicate rate limits
+const (
+	HeaderAppRateLimit         = "X-App-Rate-Limit"
+	HeaderAppRateLimitCount    = "X-App-Rate-Limit-Count"
+	HeaderMethodRateLimit      = "X-Method-Rate-Limit"
+	HeaderMethodRateLimitCount = "X-Method-Rate-Limit-Count"
+	HeaderRateLimitType        = "X-Rate-Limit-Type"
+	HeaderRetryAfter           = "Retry-After"
+)
+
+// Type is the type of rate limit which was exceeded, as reported by the X-Rate-Limit-Type header
+type Type string
+
+// All possible rate limit types
+const (
+	TypeApplication Type = "application"
+	TypeMethod      Type = "method"
+	TypeService     Type = "service"
+)
@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is a single rate limit allowing Count requests per Window.
+// When parsed from a count header Count is the number of requests already made in the window.
+type Limit struct {
+	Count  int
+	Window time.Duration
+}
+
+// String returns the limit in the format used by the rate limit headers
+func (l Limit) String() string {
+	return fmt.Sprintf("%d:%d", l.Count, int(l.Window/time.Second))
+}
+
+// ParseLimits parses the value of a rate limit header, e.g. "20:1,100:120" for 20 requests per second
+// and 100 requests per two minutes. An empty value results in no limits.
+func ParseLimits(value string) ([]Limit, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	parts := strings.Split(value, ",")
+	limits := make([]Limit, 0, len(parts))
+	for _, part := range parts {
+		pair := strings.SplitN(strings.TrimSpace(part), ":", 2)
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("invalid rate limit %q", part)
+		}
+		count, err := strconv.Atoi(pair[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limit count %q: %w", pair[0], err)
+		}
+		seconds, err := strconv.Atoi(pair[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limit window %q: %w", pair[1], err)
+		}
+		limits = append(limits, Limit{Count: count, Window: time.Duration(seconds) * time.Second})
+	}
+	return limits, nil
+}
@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []Limit
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			value: "",
+		},
+		{
+			name:  "single",
+			value: "2000:60",
+			want:  []Limit{{Count: 2000, Window: time.Minute}},
+		},
+		{
+			name:  "multiple",
+			value: "20:1, 100:120",
+			want:  []Limit{{Count: 20, Window: time.Second}, {Count: 100, Window: 2 * time.Minute}},
+		},
+		{
+			name:    "missing window",
+			value:   "20",
+			wantErr: true,
+		},
+		{
+			name:    "invalid count",
+			value:   "a:1",
+			wantErr: true,
+		},
+		{
+			name:    "invalid window",
+			value:   "1:a",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := ParseLimits(tt.value)
+				require.Equal(t, tt.wantErr, err != nil)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
+
+func TestLimit_String(t *testing.T) {
+	assert.Equal(t, "100:120", Limit{Count: 100, Window: 2 * time.Minute}.String())
+}
@@ -0,0 +1,100 @@
+// Package ratelimit provides a client side rate limiter for the Riot API. The limiter learns the application
+// and method rate limits from the response headers and delays requests which would exceed them.
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// Limiter tracks the application rate limits per region and the method rate limits per region and method.
+// Requests are delayed until every limit they are subject to has capacity left.
+// A Limiter is safe for concurrent use.
+type Limiter struct {
+	store Store
+}
+
+// NewLimiter returns a new limiter keeping its state in memory.
+// Limits are learned from the responses passed to Update.
+func NewLimiter() *Limiter {
+	return NewLimiterWithStore(NewMemoryStore())
+}
+
+// NewLimiterWithStore returns a new limiter keeping its state in the given store
+func NewLimiterWithStore(store Store) *Limiter {
+	return &Limiter{
+		store: store,
+	}
+}
+
+// Wait blocks until a request for the method may be sent to the region without exceeding a known rate limit.
+// The request is counted against all limits before Wait returns. The context error is returned if the context
+// is done before the request may be sent.
+func (l *Limiter) Wait(ctx context.Context, region, method string) error {
+	keys := []string{appKey(region), methodKey(region, method)}
+	for {
+		delay, err := l.store.Take(ctx, keys)
+		if err != nil {
+			return err
+		}
+		if delay <= 0 {
+			return nil
+		}
+		if err := internal.Sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// Update records the rate limit headers of a response for the method sent to the region.
+// If the response carries a Retry-After header and the X-Rate-Limit-Type header reports an exceeded application
+// or method limit, that limit is blocked for the given duration. Service limits are not tied to the limits of the
+// API key and do not block anything. Malformed headers and errors of the store are ignored.
+func (l *Limiter) Update(region, method string, header http.Header) {
+	ctx := context.Background()
+	app, meth := appKey(region), methodKey(region, method)
+	if limits, counts, ok := parseHeaders(header, HeaderAppRateLimit, HeaderAppRateLimitCount); ok {
+		_ = l.store.Observe(ctx, app, limits, counts)
+	}
+	if limits, counts, ok := parseHeaders(header, HeaderMethodRateLimit, HeaderMethodRateLimitCount); ok {
+		_ = l.store.Observe(ctx, meth, limits, counts)
+	}
+	seconds, err := strconv.Atoi(header.Get(HeaderRetryAfter))
+	if err != nil {
+		return
+	}
+	var key string
+	switch Type(header.Get(HeaderRateLimitType)) {
+	case TypeApplication:
+		key = app
+	case TypeMethod:
+		key = meth
+	default:
+		return
+	}
+	_ = l.store.Block(ctx, key, time.Duration(seconds)*time.Second)
+}
+
+func parseHeaders(header http.Header, limitKey, countKey string) ([]Limit, []Limit, bool) {
+	limits, err := ParseLimits(header.Get(limitKey))
+	if err != nil || len(limits) == 0 {
+		return nil, nil, false
+	}
+	counts, err := ParseLimits(header.Get(countKey))
+	if err != nil {
+		counts = nil
+	}
+	return limits, counts, true
+}
+
+func appKey(region string) string {
+	return region
+}
+
+func methodKey(region, method string) string {
+	return region + method
+}
@@ -0,0 +1,141 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+var _ internal.RateLimiter = (*Limiter)(nil)
+
+func TestLimiter_Wait(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		header  http.Header
+		method  string
+		wantErr error
+	}{
+		{
+			name: "no limits known",
+		},
+		{
+			name: "application limit exhausted",
+			header: http.Header{
+				HeaderAppRateLimit:      []string{"1:10"},
+				HeaderAppRateLimitCount: []string{"1:10"},
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+		{
+			name: "method limit exhausted",
+			header: http.Header{
+				HeaderAppRateLimit:         []string{"100:10"},
+				HeaderAppRateLimitCount:    []string{"1:10"},
+				HeaderMethodRateLimit:      []string{"1:10"},
+				HeaderMethodRateLimitCount: []string{"1:10"},
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+		{
+			name: "other method",
+			header: http.Header{
+				HeaderAppRateLimit:         []string{"100:10"},
+				HeaderAppRateLimitCount:    []string{"1:10"},
+				HeaderMethodRateLimit:      []string{"1:10"},
+				HeaderMethodRateLimitCount: []string{"1:10"},
+			},
+			method: "/other",
+		},
+		{
+			name: "retry after",
+			header: http.Header{
+				HeaderRetryAfter:    []string{"10"},
+				HeaderRateLimitType: []string{string(TypeApplication)},
+			},
+			method:  "/other",
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				l := NewLimiter()
+				l.Update("euw1", "/endpoint", tt.header)
+				method := tt.method
+				if method == "" {
+					method = "/endpoint"
+				}
+				ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+				defer cancel()
+				assert.Equal(t, tt.wantErr, l.Wait(ctx, "euw1", method))
+			},
+		)
+	}
+}
+
+func TestLimiter_Update(t *testing.T) {
+	store := NewMemoryStore()
+	l := NewLimiterWithStore(store)
+	l.Update(
+		"euw1", "/endpoint", http.Header{
+			HeaderAppRateLimit:      []string{"invalid"},
+			HeaderAppRateLimitCount: []string{"1:1"},
+			HeaderRetryAfter:        []string{"invalid"},
+		},
+	)
+	assert.Empty(t, store.bucket(appKey("euw1")).windows)
+	l.Update(
+		"euw1", "/endpoint", http.Header{
+			HeaderAppRateLimit:         []string{"20:1"},
+			HeaderAppRateLimitCount:    []string{"5:1"},
+			HeaderMethodRateLimit:      []string{"10:1"},
+			HeaderMethodRateLimitCount: []string{"invalid"},
+			HeaderRetryAfter:           []string{"1"},
+			HeaderRateLimitType:        []string{string(TypeMethod)},
+		},
+	)
+	windows := store.bucket(appKey("euw1")).windows
+	assert.Len(t, windows, 1)
+	assert.Equal(t, 5, windows[0].count)
+	windows = store.bucket(methodKey("euw1", "/endpoint")).windows
+	assert.Len(t, windows, 1)
+	assert.Equal(t, 0, windows[0].count)
+	assert.False(t, store.bucket(methodKey("euw1", "/endpoint")).blockedUntil.IsZero())
+}
+
+func TestLimiter_UpdateRetryAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		limitType  Type
+		wantApp    bool
+		wantMethod bool
+	}{
+		{name: "application", limitType: TypeApplication, wantApp: true},
+		{name: "method", limitType: TypeMethod, wantMethod: true},
+		{name: "service", limitType: TypeService},
+		{name: "missing type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			header := http.Header{HeaderRetryAfter: []string{"5"}}
+			if tt.limitType != "" {
+				header.Set(HeaderRateLimitType, string(tt.limitType))
+			}
+			NewLimiterWithStore(store).Update("euw1", "/endpoint", header)
+			assert.Equal(t, tt.wantApp, !store.bucket(appKey("euw1")).blockedUntil.IsZero())
+			assert.Equal(t, tt.wantMethod, !store.bucket(methodKey("euw1", "/endpoint")).blockedUntil.IsZero())
+		})
+	}
+}
+
+func TestLimiter_WaitStoreError(t *testing.T) {
+	l := NewLimiterWithStore(NewRemoteStore("127.0.0.1:0"))
+	assert.NotNil(t, l.Wait(context.Background(), "euw1", "/endpoint"))
+}
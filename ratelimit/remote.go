@@ -0,0 +1,299 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The line protocol spoken between RemoteStore and Server. Every command and reply is a single line,
+// keys are query escaped and limits use the format of the rate limit headers with "-" for no limits.
+//
+//	TAKE <key> [<key> ...]          -> OK | WAIT <milliseconds>
+//	OBSERVE <key> <limits> <counts> -> OK
+//	BLOCK <key> <milliseconds>      -> OK
+//
+// Any command may be answered with ERR <message>.
+const (
+	commandTake    = "TAKE"
+	commandObserve = "OBSERVE"
+	commandBlock   = "BLOCK"
+	replyOK        = "OK"
+	replyWait      = "WAIT"
+	replyError     = "ERR"
+	noLimits       = "-"
+)
+
+// Server serves a store to RemoteStores over a line based protocol. Running a single server and pointing the
+// limiters of all processes sharing an API key at it makes them share one rate limit budget.
+type Server struct {
+	store Store
+}
+
+// NewServer returns a new server for the given store
+func NewServer(store Store) *Server {
+	return &Server{
+		store: store,
+	}
+}
+
+// Serve accepts connections on the listener and handles them until accepting fails, e.g. because the listener
+// was closed. The error returned by Accept is returned.
+func (s *Server) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	writer := bufio.NewWriter(conn)
+	for scanner.Scan() {
+		if _, err := writer.WriteString(s.handle(scanner.Text()) + "\n"); err != nil {
+			return
+		}
+		if err := writer.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) handle(line string) string {
+	ctx := context.Background()
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return replyError + " invalid command"
+	}
+	key, err := url.QueryUnescape(fields[1])
+	if err != nil {
+		return replyError + " invalid key"
+	}
+	switch fields[0] {
+	case commandTake:
+		keys := make([]string, 0, len(fields)-1)
+		for _, field := range fields[1:] {
+			key, err := url.QueryUnescape(field)
+			if err != nil {
+				return replyError + " invalid key"
+			}
+			keys = append(keys, key)
+		}
+		delay, err := s.store.Take(ctx, keys)
+		if err != nil {
+			return replyError + " " + err.Error()
+		}
+		if delay > 0 {
+			return fmt.Sprintf("%s %d", replyWait, (delay+time.Millisecond-1)/time.Millisecond)
+		}
+		return replyOK
+	case commandObserve:
+		if len(fields) != 4 {
+			return replyError + " invalid command"
+		}
+		limits, err := parseLimitsArgument(fields[2])
+		if err != nil {
+			return replyError + " " + err.Error()
+		}
+		counts, err := parseLimitsArgument(fields[3])
+		if err != nil {
+			return replyError + " " + err.Error()
+		}
+		if err := s.store.Observe(ctx, key, limits, counts); err != nil {
+			return replyError + " " + err.Error()
+		}
+		return replyOK
+	case commandBlock:
+		if len(fields) != 3 {
+			return replyError + " invalid command"
+		}
+		milliseconds, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return replyError + " invalid duration"
+		}
+		if err := s.store.Block(ctx, key, time.Duration(milliseconds)*time.Millisecond); err != nil {
+			return replyError + " " + err.Error()
+		}
+		return replyOK
+	default:
+		return replyError + " unknown command"
+	}
+}
+
+// DefaultRemoteTimeout is the default Timeout of a RemoteStore
+const DefaultRemoteTimeout = 5 * time.Second
+
+// RemoteStore is a Store kept by a Server, allowing limiters in several processes to share their buckets.
+// Commands are sent over a single connection which is reestablished after a failure.
+// A RemoteStore is safe for concurrent use.
+type RemoteStore struct {
+	// Timeout limits connecting to the server and every command, even if the context of the command has no
+	// deadline, so a stalled server can not block the limiter forever. Zero means no limit. It must not be
+	// changed once the store is in use.
+	Timeout time.Duration
+
+	address string
+	dialer  net.Dialer
+	mu      sync.Mutex
+	conn    net.Conn
+	reader  *bufio.Reader
+}
+
+// NewRemoteStore returns a new store kept by the server listening on the given TCP address.
+// The connection is established with the first command.
+func NewRemoteStore(address string) *RemoteStore {
+	return &RemoteStore{
+		Timeout: DefaultRemoteTimeout,
+		address: address,
+	}
+}
+
+// Take implements the Store interface
+func (s *RemoteStore) Take(ctx context.Context, keys []string) (time.Duration, error) {
+	escaped := make([]string, 0, len(keys))
+	for _, key := range keys {
+		escaped = append(escaped, url.QueryEscape(key))
+	}
+	reply, err := s.do(ctx, commandTake+" "+strings.Join(escaped, " "))
+	if err != nil {
+		return 0, err
+	}
+	if reply == replyOK {
+		return 0, nil
+	}
+	milliseconds, err := strconv.Atoi(strings.TrimPrefix(reply, replyWait+" "))
+	if err != nil {
+		return 0, fmt.Errorf("invalid reply %q", reply)
+	}
+	return time.Duration(milliseconds) * time.Millisecond, nil
+}
+
+// Observe implements the Store interface
+func (s *RemoteStore) Observe(ctx context.Context, key string, limits, counts []Limit) error {
+	_, err := s.do(
+		ctx, fmt.Sprintf(
+			"%s %s %s %s", commandObserve, url.QueryEscape(key), formatLimitsArgument(limits),
+			formatLimitsArgument(counts),
+		),
+	)
+	return err
+}
+
+// Block implements the Store interface
+func (s *RemoteStore) Block(ctx context.Context, key string, d time.Duration) error {
+	_, err := s.do(ctx, fmt.Sprintf("%s %s %d", commandBlock, url.QueryEscape(key), d.Milliseconds()))
+	return err
+}
+
+// Close closes the connection to the server. The next command will establish a new connection.
+func (s *RemoteStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closeConn()
+}
+
+func (s *RemoteStore) do(ctx context.Context, command string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Timeout)
+		defer cancel()
+	}
+	if s.conn == nil {
+		conn, err := s.dialer.DialContext(ctx, "tcp", s.address)
+		if err != nil {
+			return "", err
+		}
+		s.conn = conn
+		s.reader = bufio.NewReader(conn)
+	}
+	deadline, _ := ctx.Deadline()
+	if err := s.conn.SetDeadline(deadline); err != nil {
+		_ = s.closeConn()
+		return "", err
+	}
+	stop := interrupt(ctx, s.conn)
+	reply, err := s.roundTrip(command)
+	stop()
+	if err != nil {
+		_ = s.closeConn()
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", err
+	}
+	reply = strings.TrimSpace(reply)
+	if strings.HasPrefix(reply, replyError) {
+		return "", errors.New(strings.TrimSpace(strings.TrimPrefix(reply, replyError)))
+	}
+	return reply, nil
+}
+
+func (s *RemoteStore) roundTrip(command string) (string, error) {
+	if _, err := s.conn.Write([]byte(command + "\n")); err != nil {
+		return "", err
+	}
+	return s.reader.ReadString('\n')
+}
+
+// interrupt unblocks reads and writes on the connection once the context is done by moving its deadline into
+// the past. The returned function stops watching the context and returns once the watcher finished.
+func interrupt(ctx context.Context, conn net.Conn) func() {
+	if ctx.Done() == nil {
+		return func() {}
+	}
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		select {
+		case <-ctx.Done():
+			_ = conn.SetDeadline(time.Unix(1, 0))
+		case <-stop:
+		}
+	}()
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
+func (s *RemoteStore) closeConn() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	s.reader = nil
+	return err
+}
+
+func formatLimitsArgument(limits []Limit) string {
+	if len(limits) == 0 {
+		return noLimits
+	}
+	parts := make([]string, 0, len(limits))
+	for _, limit := range limits {
+		parts = append(parts, limit.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+func parseLimitsArgument(argument string) ([]Limit, error) {
+	if argument == noLimits {
+		return nil, nil
+	}
+	return ParseLimits(argument)
+}
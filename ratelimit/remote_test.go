@@ -0,0 +1,129 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteStore(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer listener.Close()
+	go func() {
+		_ = NewServer(NewMemoryStore()).Serve(listener)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	first := NewRemoteStore(listener.Addr().String())
+	defer first.Close()
+	second := NewRemoteStore(listener.Addr().String())
+	defer second.Close()
+	keys := []string{"euw1", "euw1/lol/match/v5/matches/%s"}
+	require.Nil(t, first.Observe(ctx, keys[0], []Limit{{Count: 2, Window: 10 * time.Second}}, nil))
+	d, err := first.Take(ctx, keys)
+	require.Nil(t, err)
+	assert.Equal(t, time.Duration(0), d)
+	d, err = second.Take(ctx, keys)
+	require.Nil(t, err)
+	assert.Equal(t, time.Duration(0), d)
+	d, err = second.Take(ctx, keys)
+	require.Nil(t, err)
+	assert.Greater(t, d, 9*time.Second)
+	require.Nil(t, second.Block(ctx, "euw1/other", time.Minute))
+	d, err = first.Take(ctx, []string{"euw1/other"})
+	require.Nil(t, err)
+	assert.Greater(t, d, 59*time.Second)
+	require.Nil(t, first.Close())
+	require.Nil(t, first.Observe(ctx, keys[1], nil, nil))
+}
+
+// silentServer returns the address of a server which accepts connections but never replies
+func silentServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		var conns []net.Conn
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				for _, conn := range conns {
+					_ = conn.Close()
+				}
+				return
+			}
+			conns = append(conns, conn)
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func TestRemoteStore_Cancel(t *testing.T) {
+	store := NewRemoteStore(silentServer(t))
+	defer store.Close()
+	store.Timeout = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	start := time.Now()
+	_, err := store.Take(ctx, []string{"euw1"})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestRemoteStore_Timeout(t *testing.T) {
+	store := NewRemoteStore(silentServer(t))
+	defer store.Close()
+	assert.Equal(t, DefaultRemoteTimeout, store.Timeout)
+	store.Timeout = 50 * time.Millisecond
+	start := time.Now()
+	_, err := store.Take(context.Background(), []string{"euw1"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	// the limiter ignores errors of the store, but must not block on it
+	header := http.Header{HeaderRetryAfter: []string{"1"}, HeaderRateLimitType: []string{string(TypeMethod)}}
+	NewLimiterWithStore(store).Update("euw1", "/endpoint", header)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestServer_handle(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Now()
+	store.now = func() time.Time {
+		return now
+	}
+	s := NewServer(store)
+	tests := []struct {
+		line string
+		want string
+	}{
+		{line: "", want: "ERR invalid command"},
+		{line: "TAKE %zz", want: "ERR invalid key"},
+		{line: "TAKE a %zz", want: "ERR invalid key"},
+		{line: "TAKE a b", want: "OK"},
+		{line: "OBSERVE a", want: "ERR invalid command"},
+		{line: "OBSERVE a x -", want: `ERR invalid rate limit "x"`},
+		{line: "OBSERVE a 1:1 x", want: `ERR invalid rate limit "x"`},
+		{line: "OBSERVE a 1:1 1:1", want: "OK"},
+		{line: "TAKE a", want: "WAIT 1000"},
+		{line: "BLOCK a", want: "ERR invalid command"},
+		{line: "BLOCK a x", want: "ERR invalid duration"},
+		{line: "BLOCK b 2000", want: "OK"},
+		{line: "TAKE b", want: "WAIT 2000"},
+		{line: "FOO a", want: "ERR unknown command"},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.line, func(t *testing.T) {
+				assert.Equal(t, tt.want, s.handle(tt.line))
+			},
+		)
+	}
+}
@@ -0,0 +1,160 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Store holds the state of all rate limit buckets. Limiters sharing one store coordinate their consumption
+// of the rate limits, e.g. several processes using the same API key share a RemoteStore.
+type Store interface {
+	// Take counts a request against the buckets with the given keys if all of them have capacity left and
+	// returns zero. Otherwise nothing is counted and the duration until the buckets might have capacity is
+	// returned.
+	Take(ctx context.Context, keys []string) (time.Duration, error)
+	// Observe replaces the limits of the bucket with the given key and raises its counts to the given ones
+	Observe(ctx context.Context, key string, limits, counts []Limit) error
+	// Block rejects requests in the bucket with the given key for the given duration
+	Block(ctx context.Context, key string, d time.Duration) error
+}
+
+// MemoryStore is a Store keeping all buckets in memory. It only coordinates limiters within a single process.
+// A MemoryStore is safe for concurrent use.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// NewMemoryStore returns a new in-memory store without any buckets
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// Take implements the Store interface
+func (s *MemoryStore) Take(_ context.Context, keys []string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	var delay time.Duration
+	buckets := make([]*bucket, 0, len(keys))
+	for _, key := range keys {
+		b := s.bucket(key)
+		if d := b.delay(now); d > delay {
+			delay = d
+		}
+		buckets = append(buckets, b)
+	}
+	if delay > 0 {
+		return delay, nil
+	}
+	for _, b := range buckets {
+		b.take()
+	}
+	return 0, nil
+}
+
+// Observe implements the Store interface
+func (s *MemoryStore) Observe(_ context.Context, key string, limits, counts []Limit) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bucket(key).update(limits, counts, s.now())
+	return nil
+}
+
+// Block implements the Store interface
+func (s *MemoryStore) Block(_ context.Context, key string, d time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bucket(key).block(s.now().Add(d))
+	return nil
+}
+
+func (s *MemoryStore) bucket(key string) *bucket {
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{}
+		s.buckets[key] = b
+	}
+	return b
+}
+
+// window counts the requests made since start against a single limit
+type window struct {
+	limit Limit
+	count int
+	start time.Time
+}
+
+// bucket holds all windows of either the application or a method limit
+type bucket struct {
+	windows      []*window
+	blockedUntil time.Time
+}
+
+// delay returns how long to wait until a request fits into all windows, resetting windows which have expired
+func (b *bucket) delay(now time.Time) time.Duration {
+	delay := b.blockedUntil.Sub(now)
+	for _, w := range b.windows {
+		end := w.start.Add(w.limit.Window)
+		if !now.Before(end) {
+			w.start = now
+			w.count = 0
+			continue
+		}
+		if w.count >= w.limit.Count {
+			if d := end.Sub(now); d > delay {
+				delay = d
+			}
+		}
+	}
+	return delay
+}
+
+func (b *bucket) take() {
+	for _, w := range b.windows {
+		w.count++
+	}
+}
+
+func (b *bucket) block(until time.Time) {
+	if until.After(b.blockedUntil) {
+		b.blockedUntil = until
+	}
+}
+
+// update replaces the limits with the given ones and raises the counts to the given ones.
+// Without any limits the bucket is left unchanged.
+func (b *bucket) update(limits, counts []Limit, now time.Time) {
+	if len(limits) == 0 {
+		return
+	}
+	windows := make([]*window, 0, len(limits))
+	for _, limit := range limits {
+		w := b.window(limit.Window)
+		if w == nil {
+			w = &window{start: now}
+		}
+		w.limit = limit
+		windows = append(windows, w)
+	}
+	b.windows = windows
+	for _, count := range counts {
+		if w := b.window(count.Window); w != nil && count.Count > w.count {
+			w.count = count.Count
+		}
+	}
+}
+
+func (b *bucket) window(d time.Duration) *window {
+	for _, w := range b.windows {
+		if w.limit.Window == d {
+			return w
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(0, 0)
+	s := NewMemoryStore()
+	s.now = func() time.Time {
+		return now
+	}
+	keys := []string{"app", "method"}
+	require.Nil(
+		t, s.Observe(
+			ctx, "app", []Limit{{Count: 2, Window: time.Second}, {Count: 3, Window: 10 * time.Second}},
+			[]Limit{{Count: 1, Window: time.Second}, {Count: 1, Window: 10 * time.Second}},
+		),
+	)
+	take := func() time.Duration {
+		d, err := s.Take(ctx, keys)
+		require.Nil(t, err)
+		return d
+	}
+	assert.Equal(t, time.Duration(0), take())
+	assert.Equal(t, time.Second, take())
+	now = now.Add(time.Second)
+	assert.Equal(t, time.Duration(0), take())
+	assert.Equal(t, 9*time.Second, take())
+	now = now.Add(9 * time.Second)
+	assert.Equal(t, time.Duration(0), take())
+}
+
+func TestMemoryStore_Block(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(0, 0)
+	s := NewMemoryStore()
+	s.now = func() time.Time {
+		return now
+	}
+	require.Nil(t, s.Block(ctx, "method", 5*time.Second))
+	require.Nil(t, s.Block(ctx, "method", time.Second))
+	d, err := s.Take(ctx, []string{"app", "method"})
+	require.Nil(t, err)
+	assert.Equal(t, 5*time.Second, d)
+	d, err = s.Take(ctx, []string{"app"})
+	require.Nil(t, err)
+	assert.Equal(t, time.Duration(0), d)
+}
@@ -0,0 +1,28 @@
+package retry
+
+import "time"
+
+// Backoff returns the delay before the given retry of a request, starting at 1 for the first retry
+type Backoff func(retry int) time.Duration
+
+// Constant returns a backoff waiting the same duration before every retry
+func Constant(d time.Duration) Backoff {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// Exponential returns a backoff doubling the delay with every retry, starting at initial and never
+// exceeding max
+func Exponential(initial, max time.Duration) Backoff {
+	return func(retry int) time.Duration {
+		d := initial
+		for i := 1; i < retry && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		return d
+	}
+}
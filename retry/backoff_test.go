@@ -0,0 +1,23 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstant(t *testing.T) {
+	b := Constant(time.Second)
+	assert.Equal(t, time.Second, b(1))
+	assert.Equal(t, time.Second, b(10))
+}
+
+func TestExponential(t *testing.T) {
+	b := Exponential(time.Second, 5*time.Second)
+	assert.Equal(t, time.Second, b(1))
+	assert.Equal(t, 2*time.Second, b(2))
+	assert.Equal(t, 4*time.Second, b(3))
+	assert.Equal(t, 5*time.Second, b(4))
+	assert.Equal(t, 5*time.Second, b(100))
+}
@@ -0,0 +1,173 @@
+// Package retry provides configurable retry policies for requests to the Riot API.
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Policy configures if and when failed requests are retried
+type Policy struct {
+	// MaxAttempts limits the number of attempts per request including the first one. Zero means no limit.
+	MaxAttempts int
+	// Backoff returns the delay before a retry. A valid Retry-After header of a response takes precedence.
+	Backoff Backoff
+	// Jitter randomizes the delay by up to the given fraction of it in either direction, e.g. 0.2 for ±20%
+	Jitter float64
+	// Statuses maps the status codes which are retried to the maximum number of retries for responses with
+	// that status. Zero means no limit besides MaxAttempts.
+	Statuses map[int]int
+	// NetworkErrors enables retrying requests which failed without a response. Requests failing because
+	// their context is done are never retried.
+	NetworkErrors bool
+	// Methods lists the HTTP methods of the requests whose network errors and responses other than rate limited
+	// ones are retried. Nil means GET and HEAD only, as other requests like the creation of tournament codes
+	// might have taken effect on the server before failing. Rate limited responses are retried for all methods.
+	Methods []string
+	// StrictRetryAfter fails rate limited responses without a valid Retry-After header instead of waiting for
+	// the backoff
+	StrictRetryAfter bool
+	// OnRetry is called before waiting for every retry if set
+	OnRetry func(Retry)
+}
+
+// Retry describes a retry which is about to happen
+type Retry struct {
+	// Attempt is the number of the attempt which failed, starting at 1
+	Attempt int
+	// Delay is the duration waited before the next attempt
+	Delay time.Duration
+	// Response is the response of the failed attempt, nil if the attempt failed with an error
+	Response *http.Response
+	// Err is the error of the failed attempt, nil if the attempt failed with a response
+	Err error
+}
+
+// Legacy returns the policy golio used before retry policies became configurable: service unavailable
+// responses are retried once after a second and rate limited responses are retried without limit after
+// waiting for their Retry-After header, failing if the header is missing. Unlike before, service unavailable
+// responses are only retried for GET and HEAD requests.
+func Legacy() *Policy {
+	return &Policy{
+		Backoff: Constant(time.Second),
+		Statuses: map[int]int{
+			http.StatusTooManyRequests:    0,
+			http.StatusServiceUnavailable: 1,
+		},
+		StrictRetryAfter: true,
+	}
+}
+
+// Default returns a policy retrying rate limited responses up to four times with an exponential backoff
+// starting at half a second. Server errors and network errors are retried as well for GET and HEAD requests.
+func Default() *Policy {
+	return &Policy{
+		MaxAttempts: 5,
+		Backoff:     Exponential(500*time.Millisecond, 30*time.Second),
+		Jitter:      0.2,
+		Statuses: map[int]int{
+			http.StatusTooManyRequests:     0,
+			http.StatusInternalServerError: 0,
+			http.StatusBadGateway:          0,
+			http.StatusServiceUnavailable:  0,
+			http.StatusGatewayTimeout:      0,
+		},
+		NetworkErrors: true,
+	}
+}
+
+// NewRetrier returns a retrier applying the policy to a single request
+func (p *Policy) NewRetrier() *Retrier {
+	return &Retrier{
+		policy:  p,
+		retries: map[int]int{},
+	}
+}
+
+// Retrier decides about the retries of a single request according to a policy
+type Retrier struct {
+	policy  *Policy
+	attempt int
+	retries map[int]int
+}
+
+// Next is called after every attempt of a request with the given HTTP method with its response or error. It
+// returns whether the request should be retried and the delay before the next attempt. An error is returned if
+// the response can not be retried as configured, e.g. a rate limited response without a Retry-After header and
+// StrictRetryAfter set.
+func (r *Retrier) Next(method string, response *http.Response, err error) (time.Duration, bool, error) {
+	r.attempt++
+	p := r.policy
+	if p.MaxAttempts > 0 && r.attempt >= p.MaxAttempts {
+		return 0, false, nil
+	}
+	if err != nil {
+		if !p.NetworkErrors || !p.retryable(method) || errors.Is(err, context.Canceled) ||
+			errors.Is(err, context.DeadlineExceeded) {
+			return 0, false, nil
+		}
+		return r.delay(p.jitter(p.backoff(r.attempt)), nil, err), true, nil
+	}
+	limit, ok := p.Statuses[response.StatusCode]
+	if !ok || (limit > 0 && r.retries[response.StatusCode] >= limit) {
+		return 0, false, nil
+	}
+	if response.StatusCode != http.StatusTooManyRequests && !p.retryable(method) {
+		return 0, false, nil
+	}
+	r.retries[response.StatusCode]++
+	seconds, err := strconv.Atoi(response.Header.Get("Retry-After"))
+	if err == nil {
+		return r.delay(time.Duration(seconds)*time.Second, response, nil), true, nil
+	}
+	if p.StrictRetryAfter && response.StatusCode == http.StatusTooManyRequests {
+		return 0, false, err
+	}
+	return r.delay(p.jitter(p.backoff(r.attempt)), response, nil), true, nil
+}
+
+func (r *Retrier) delay(d time.Duration, response *http.Response, err error) time.Duration {
+	if r.policy.OnRetry != nil {
+		r.policy.OnRetry(
+			Retry{
+				Attempt:  r.attempt,
+				Delay:    d,
+				Response: response,
+				Err:      err,
+			},
+		)
+	}
+	return d
+}
+
+// retryable returns whether network errors and responses other than rate limited ones are retried for requests
+// with the given HTTP method
+func (p *Policy) retryable(method string) bool {
+	if p.Methods == nil {
+		return method == http.MethodGet || method == http.MethodHead
+	}
+	for _, m := range p.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) backoff(retry int) time.Duration {
+	if p.Backoff == nil {
+		return 0
+	}
+	return p.Backoff(retry)
+}
+
+func (p *Policy) jitter(d time.Duration) time.Duration {
+	if p.Jitter <= 0 {
+		return d
+	}
+	return d + time.Duration(p.Jitter*(2*rand.Float64()-1)*float64(d))
+}
@@ -0,0 +1,222 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type attempt struct {
+	status     int
+	retryAfter string
+	err        error
+}
+
+type decision struct {
+	delay   time.Duration
+	retry   bool
+	wantErr bool
+}
+
+func TestRetrier_Next(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   *Policy
+		method   string
+		attempts []attempt
+		want     []decision
+	}{
+		{
+			name:   "legacy service unavailable once",
+			policy: Legacy(),
+			attempts: []attempt{
+				{status: http.StatusServiceUnavailable},
+				{status: http.StatusServiceUnavailable},
+			},
+			want: []decision{
+				{delay: time.Second, retry: true},
+				{},
+			},
+		},
+		{
+			name:   "legacy rate limited",
+			policy: Legacy(),
+			attempts: []attempt{
+				{status: http.StatusTooManyRequests, retryAfter: "3"},
+				{status: http.StatusTooManyRequests, retryAfter: "2"},
+				{status: http.StatusTooManyRequests},
+			},
+			want: []decision{
+				{delay: 3 * time.Second, retry: true},
+				{delay: 2 * time.Second, retry: true},
+				{wantErr: true},
+			},
+		},
+		{
+			name:   "legacy network error",
+			policy: Legacy(),
+			attempts: []attempt{
+				{err: fmt.Errorf("error")},
+			},
+			want: []decision{
+				{},
+			},
+		},
+		{
+			name: "max attempts",
+			policy: &Policy{
+				MaxAttempts: 3,
+				Backoff:     Exponential(time.Second, time.Minute),
+				Statuses:    map[int]int{http.StatusInternalServerError: 0},
+			},
+			attempts: []attempt{
+				{status: http.StatusInternalServerError},
+				{status: http.StatusInternalServerError},
+				{status: http.StatusInternalServerError},
+			},
+			want: []decision{
+				{delay: time.Second, retry: true},
+				{delay: 2 * time.Second, retry: true},
+				{},
+			},
+		},
+		{
+			name: "rate limited without retry after",
+			policy: &Policy{
+				Backoff:  Constant(time.Second),
+				Statuses: map[int]int{http.StatusTooManyRequests: 0},
+			},
+			attempts: []attempt{
+				{status: http.StatusTooManyRequests, retryAfter: "abc"},
+			},
+			want: []decision{
+				{delay: time.Second, retry: true},
+			},
+		},
+		{
+			name: "network errors",
+			policy: &Policy{
+				Backoff:       Constant(time.Second),
+				NetworkErrors: true,
+			},
+			attempts: []attempt{
+				{err: fmt.Errorf("error")},
+				{err: context.Canceled},
+			},
+			want: []decision{
+				{delay: time.Second, retry: true},
+				{},
+			},
+		},
+		{
+			name:   "post server errors",
+			policy: Default(),
+			method: http.MethodPost,
+			attempts: []attempt{
+				{status: http.StatusServiceUnavailable},
+			},
+			want: []decision{
+				{},
+			},
+		},
+		{
+			name:   "post network error",
+			policy: Default(),
+			method: http.MethodPost,
+			attempts: []attempt{
+				{err: fmt.Errorf("error")},
+			},
+			want: []decision{
+				{},
+			},
+		},
+		{
+			name:   "post rate limited",
+			policy: Default(),
+			method: http.MethodPost,
+			attempts: []attempt{
+				{status: http.StatusTooManyRequests, retryAfter: "2"},
+			},
+			want: []decision{
+				{delay: 2 * time.Second, retry: true},
+			},
+		},
+		{
+			name: "post opt in",
+			policy: &Policy{
+				Backoff:       Constant(time.Second),
+				Statuses:      map[int]int{http.StatusServiceUnavailable: 0},
+				NetworkErrors: true,
+				Methods:       []string{http.MethodGet, http.MethodPost},
+			},
+			method: http.MethodPost,
+			attempts: []attempt{
+				{status: http.StatusServiceUnavailable},
+				{err: fmt.Errorf("error")},
+			},
+			want: []decision{
+				{delay: time.Second, retry: true},
+				{delay: time.Second, retry: true},
+			},
+		},
+		{
+			name:   "not retryable status",
+			policy: Default(),
+			attempts: []attempt{
+				{status: http.StatusNotFound},
+			},
+			want: []decision{
+				{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				r := tt.policy.NewRetrier()
+				method := tt.method
+				if method == "" {
+					method = http.MethodGet
+				}
+				for i, a := range tt.attempts {
+					var response *http.Response
+					if a.err == nil {
+						response = &http.Response{StatusCode: a.status, Header: http.Header{}}
+						if a.retryAfter != "" {
+							response.Header.Set("Retry-After", a.retryAfter)
+						}
+					}
+					delay, retry, err := r.Next(method, response, a.err)
+					require.Equal(t, tt.want[i].wantErr, err != nil, "attempt %d", i+1)
+					assert.Equal(t, tt.want[i].retry, retry, "attempt %d", i+1)
+					assert.Equal(t, tt.want[i].delay, delay, "attempt %d", i+1)
+				}
+			},
+		)
+	}
+}
+
+func TestPolicy_OnRetry(t *testing.T) {
+	var retries []Retry
+	p := &Policy{
+		Backoff:  Constant(time.Second),
+		Jitter:   0.5,
+		Statuses: map[int]int{http.StatusBadGateway: 0},
+		OnRetry: func(r Retry) {
+			retries = append(retries, r)
+		},
+	}
+	r := p.NewRetrier()
+	response := &http.Response{StatusCode: http.StatusBadGateway}
+	delay, retry, err := r.Next(http.MethodGet, response, nil)
+	require.Nil(t, err)
+	require.True(t, retry)
+	assert.InDelta(t, time.Second, delay, float64(time.Second/2))
+	require.Len(t, retries, 1)
+	assert.Equal(t, Retry{Attempt: 1, Delay: delay, Response: response}, retries[0])
+}
@@ -1,21 +1,30 @@
 package account
 
 import (
+	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/KnutZuidema/golio/api"
-
-	log "github.com/sirupsen/logrus"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // GetByPUUID returns the account matching the PUUID
 func (ac *Client) GetByPUUID(puuid string) (*Account, error) {
+	return ac.GetByPUUIDWithContext(context.Background(), puuid)
+}
+
+// GetByPUUIDWithContext is like GetByPUUID but binds the request to the given context
+func (ac *Client) GetByPUUIDWithContext(ctx context.Context, puuid string) (*Account, error) {
 	logger := ac.logger().WithField("method", "GetByPUUID")
 	var account Account
 	c := *ac.c
-	c.Region = api.Region(api.RegionToRoute[c.Region])
+	c.Region = api.Region(c.Region.Route())
 
-	if err := c.GetInto(
+	ctx = internal.WithEndpoint(ctx, endpointGetByPUUID)
+	if err := c.GetIntoWithContext(
+		ctx,
 		fmt.Sprintf(endpointGetByPUUID, puuid),
 		&account,
 	); err != nil {
@@ -27,13 +36,20 @@ func (ac *Client) GetByPUUID(puuid string) (*Account, error) {
 
 // GetByRiotID returns the account matching the riot id
 func (ac *Client) GetByRiotID(gameName, tagLine string) (*Account, error) {
+	return ac.GetByRiotIDWithContext(context.Background(), gameName, tagLine)
+}
+
+// GetByRiotIDWithContext is like GetByRiotID but binds the request to the given context
+func (ac *Client) GetByRiotIDWithContext(ctx context.Context, gameName, tagLine string) (*Account, error) {
 	logger := ac.logger().WithField("method", "GetByRiotID")
 	var account Account
 	c := *ac.c
-	c.Region = api.Region(api.RegionToRoute[c.Region])
+	c.Region = api.Region(c.Region.Route())
 
-	if err := c.GetInto(
-		fmt.Sprintf(endpointGetByRiotID, gameName, tagLine),
+	ctx = internal.WithEndpoint(ctx, endpointGetByRiotID)
+	if err := c.GetIntoWithContext(
+		ctx,
+		fmt.Sprintf(endpointGetByRiotID, url.PathEscape(gameName), url.PathEscape(tagLine)),
 		&account,
 	); err != nil {
 		logger.Debug(err)
@@ -42,6 +58,51 @@ func (ac *Client) GetByRiotID(gameName, tagLine string) (*Account, error) {
 	return &account, nil
 }
 
+// GetMe returns the account the given access token was issued for, e.g. an access token obtained via Riot Sign On
+func (ac *Client) GetMe(accessToken string) (*Account, error) {
+	return ac.GetMeWithContext(context.Background(), accessToken)
+}
+
+// GetMeWithContext is like GetMe but binds the request to the given context
+func (ac *Client) GetMeWithContext(ctx context.Context, accessToken string) (*Account, error) {
+	logger := ac.logger().WithField("method", "GetMe")
+	var account Account
+	c := *ac.c
+	c.Region = api.Region(c.Region.Route())
+
+	ctx = internal.WithEndpoint(ctx, endpointGetMe)
+	ctx = internal.WithBearerToken(ctx, accessToken)
+	if err := c.GetIntoWithContext(ctx, endpointGetMe, &account); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return &account, nil
+}
+
+// GetActiveShard returns the shard the player is active on for the game
+func (ac *Client) GetActiveShard(game Game, puuid string) (*ActiveShard, error) {
+	return ac.GetActiveShardWithContext(context.Background(), game, puuid)
+}
+
+// GetActiveShardWithContext is like GetActiveShard but binds the request to the given context
+func (ac *Client) GetActiveShardWithContext(ctx context.Context, game Game, puuid string) (*ActiveShard, error) {
+	logger := ac.logger().WithField("method", "GetActiveShard")
+	var shard ActiveShard
+	c := *ac.c
+	c.Region = api.Region(c.Region.Route())
+
+	ctx = internal.WithEndpoint(ctx, endpointGetActiveShard)
+	if err := c.GetIntoWithContext(
+		ctx,
+		fmt.Sprintf(endpointGetActiveShard, game, puuid),
+		&shard,
+	); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return &shard, nil
+}
+
 func (ac *Client) logger() log.FieldLogger {
 	return ac.c.Logger().WithField("category", "account")
 }
@@ -38,7 +38,7 @@ func TestAccountClient_GetByPUUID(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&Client{c: client}).GetByPUUID("")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -71,7 +71,7 @@ func TestAccountClient_GetByRiotID(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&Client{c: client}).GetByRiotID("", "")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -79,3 +79,92 @@ func TestAccountClient_GetByRiotID(t *testing.T) {
 		)
 	}
 }
+
+func TestAccountClient_GetMe(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    *Account
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: &Account{Puuid: "puuid"},
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if r.Header.Get("Authorization") != "Bearer token" {
+						return mock.NewStatusMockDoer(http.StatusUnauthorized).Do(r)
+					}
+					return mock.NewJSONMockDoer(Account{Puuid: "puuid"}, 200).Do(r)
+				},
+			},
+		},
+		{
+			name:    "unauthorized",
+			wantErr: api.ErrUnauthorized,
+			doer:    mock.NewStatusMockDoer(http.StatusUnauthorized),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&Client{c: client}).GetMe("token")
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestAccountClient_GetActiveShard(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    *ActiveShard
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: &ActiveShard{Puuid: "puuid", Game: "val", ActiveShard: "eu"},
+			doer: mock.NewJSONMockDoer(ActiveShard{Puuid: "puuid", Game: "val", ActiveShard: "eu"}, 200),
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&Client{c: client}).GetActiveShard(GameValorant, "puuid")
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestAccountClient_GetByRiotIDEscaping(t *testing.T) {
+	t.Parallel()
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if r.URL.EscapedPath() != "/riot/account/v1/accounts/by-riot-id/Hide%20on%20bush/KR%2F1" {
+				return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+			}
+			return mock.NewJSONMockDoer(Account{Puuid: "puuid"}, 200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger())
+	got, err := (&Client{c: client}).GetByRiotID("Hide on bush", "KR/1")
+	require.NoError(t, err)
+	assert.Equal(t, &Account{Puuid: "puuid"}, got)
+}
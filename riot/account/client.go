@@ -1,6 +1,9 @@
 package account
 
-import "github.com/KnutZuidema/golio/internal"
+import (
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
 
 // Client pools all methods for endpoints of the League of Legends API.
 type Client struct {
@@ -13,3 +16,8 @@ func NewClient(base *internal.Client) *Client {
 		base,
 	}
 }
+
+// ForRegion returns a client for the given region or route sharing the HTTP client, rate limiter and cache
+func (ac *Client) ForRegion(region api.Region) *Client {
+	return NewClient(ac.c.WithRegion(region))
+}
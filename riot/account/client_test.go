@@ -1,9 +1,14 @@
 package account
 
 import (
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
@@ -16,3 +21,20 @@ func TestNewClient(t *testing.T) {
 		t.Error("returned nil")
 	}
 }
+
+func TestClient_ForRegion(t *testing.T) {
+	var host string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			host = r.URL.Host
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("{}")),
+			}, nil
+		},
+	}
+	c := NewClient(internal.NewClient(api.RegionEuropeWest, "key", doer, logrus.StandardLogger()))
+	_, err := c.ForRegion(api.Region(api.RouteAsia)).GetByPUUID("puuid")
+	require.Nil(t, err)
+	assert.Equal(t, "asia.api.riotgames.com", host)
+}
@@ -1,9 +1,20 @@
 package account
 
 const (
-	endpointBase         = "/riot"
-	endpointAccountBase  = endpointBase + "/account/v1"
-	endpointAccountsBase = endpointAccountBase + "/accounts"
-	endpointGetByPUUID   = endpointAccountsBase + "/by-puuid/%s"
-	endpointGetByRiotID  = endpointAccountsBase + "/by-riot-id/%s/%s"
+	endpointBase           = "/riot"
+	endpointAccountBase    = endpointBase + "/account/v1"
+	endpointAccountsBase   = endpointAccountBase + "/accounts"
+	endpointGetByPUUID     = endpointAccountsBase + "/by-puuid/%s"
+	endpointGetByRiotID    = endpointAccountsBase + "/by-riot-id/%s/%s"
+	endpointGetMe          = endpointAccountsBase + "/me"
+	endpointGetActiveShard = endpointAccountBase + "/active-shards/by-game/%s/by-puuid/%s"
+)
+
+// Game is a game with shards, used to look up the active shard of a player
+type Game string
+
+// All games with shards
+const (
+	GameValorant           Game = "val"
+	GameLegendsOfRuneterra Game = "lor"
 )
@@ -1,8 +1,37 @@
 package account
 
+import (
+	"errors"
+	"strings"
+)
+
+// ErrInvalidRiotID is returned by ParseRiotID if the Riot ID is not of the form GameName#TagLine
+var ErrInvalidRiotID = errors.New("invalid riot id")
+
 // Account contains information about a user account
 type Account struct {
 	Puuid    string `json:"puuid"`
 	GameName string `json:"gameName"`
 	TagLine  string `json:"tagLine"`
 }
+
+// RiotID returns the Riot ID of the account in the form GameName#TagLine
+func (a *Account) RiotID() string {
+	return a.GameName + "#" + a.TagLine
+}
+
+// ParseRiotID splits a Riot ID of the form GameName#TagLine into the game name and the tag line
+func ParseRiotID(riotID string) (gameName, tagLine string, err error) {
+	i := strings.LastIndex(riotID, "#")
+	if i <= 0 || i == len(riotID)-1 {
+		return "", "", ErrInvalidRiotID
+	}
+	return riotID[:i], riotID[i+1:], nil
+}
+
+// ActiveShard contains the shard a player is active on for a game
+type ActiveShard struct {
+	Puuid       string `json:"puuid"`
+	Game        string `json:"game"`
+	ActiveShard string `json:"activeShard"`
+}
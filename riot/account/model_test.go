@@ -0,0 +1,37 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRiotID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		riotID       string
+		wantGameName string
+		wantTagLine  string
+		wantErr      error
+	}{
+		{riotID: "Hide on bush#KR1", wantGameName: "Hide on bush", wantTagLine: "KR1"},
+		{riotID: "name#with#hash", wantGameName: "name#with", wantTagLine: "hash"},
+		{riotID: "no tag line", wantErr: ErrInvalidRiotID},
+		{riotID: "#KR1", wantErr: ErrInvalidRiotID},
+		{riotID: "name#", wantErr: ErrInvalidRiotID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.riotID, func(t *testing.T) {
+			gameName, tagLine, err := ParseRiotID(tt.riotID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantGameName, gameName)
+			assert.Equal(t, tt.wantTagLine, tagLine)
+		})
+	}
+}
+
+func TestAccount_RiotID(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "Hide on bush#KR1", (&Account{GameName: "Hide on bush", TagLine: "KR1"}).RiotID())
+}
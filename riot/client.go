@@ -3,10 +3,9 @@
 package riot
 
 import (
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 	"github.com/KnutZuidema/golio/riot/account"
 	"github.com/KnutZuidema/golio/riot/lol"
 	"github.com/KnutZuidema/golio/riot/lor"
@@ -38,16 +37,23 @@ type Client struct {
 	LoL     *lol.Client
 	LoR     *lor.Client
 	Val     *val.Client
+
+	base *internal.Client
 }
 
 // NewClient returns a new api client for the Riot API
-func NewClient(region api.Region, apiKey string, client internal.Doer, logger log.FieldLogger) *Client {
-	baseClient := internal.NewClient(region, apiKey, client, logger)
+func NewClient(region api.Region, apiKey string, client internal.Doer, logger log.Logger) *Client {
+	return NewClientWithBase(internal.NewClient(region, apiKey, client, logger))
+}
+
+// NewClientWithBase returns a new api client for the Riot API sending all requests through the given base client
+func NewClientWithBase(baseClient *internal.Client) *Client {
 	c := &Client{
 		Account: account.NewClient(baseClient),
 		LoL:     lol.NewClient(baseClient),
 		LoR:     lor.NewClient(baseClient),
 		Val:     val.NewClient(baseClient),
+		base:    baseClient,
 	}
 
 	// TODO: deprecated, remove in a future release
@@ -62,3 +68,8 @@ func NewClient(region api.Region, apiKey string, client internal.Doer, logger lo
 	c.ThirdPartyCode = c.LoL.ThirdPartyCode
 	return c
 }
+
+// ForRegion returns a client for the given region sharing the HTTP client, rate limiter and cache
+func (c *Client) ForRegion(region api.Region) *Client {
+	return NewClientWithBase(c.base.WithRegion(region))
+}
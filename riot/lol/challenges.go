@@ -1,11 +1,11 @@
 package lol
 
 import (
+	"context"
 	"fmt"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // ChallengesClient provides methods for the challenges endpoints of the League of Legends API.
@@ -15,9 +15,14 @@ type ChallengesClient struct {
 
 // GetConfig returns all basic challenge configuration information
 func (cc *ChallengesClient) GetConfig() ([]*ChallengeConfigInfo, error) {
+	return cc.GetConfigWithContext(context.Background())
+}
+
+// GetConfigWithContext is like GetConfig but binds the request to the given context
+func (cc *ChallengesClient) GetConfigWithContext(ctx context.Context) ([]*ChallengeConfigInfo, error) {
 	logger := cc.logger().WithField("method", "GetConfig")
 	var challengeConfigs []*ChallengeConfigInfo
-	if err := cc.c.GetInto(endpointChallengesConfig, &challengeConfigs); err != nil {
+	if err := cc.c.GetIntoWithContext(ctx, endpointChallengesConfig, &challengeConfigs); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -32,9 +37,14 @@ type PercentilesByChallenges map[string]Percentiles
 
 // GetPercentiles returns a map of level to percentile of players who have achieved it
 func (cc *ChallengesClient) GetPercentiles() (PercentilesByChallenges, error) {
+	return cc.GetPercentilesWithContext(context.Background())
+}
+
+// GetPercentilesWithContext is like GetPercentiles but binds the request to the given context
+func (cc *ChallengesClient) GetPercentilesWithContext(ctx context.Context) (PercentilesByChallenges, error) {
 	logger := cc.logger().WithField("method", "GetPercentiles")
 	var percentiles PercentilesByChallenges
-	if err := cc.c.GetInto(endpointChallengesPercentiles, &percentiles); err != nil {
+	if err := cc.c.GetIntoWithContext(ctx, endpointChallengesPercentiles, &percentiles); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -43,9 +53,18 @@ func (cc *ChallengesClient) GetPercentiles() (PercentilesByChallenges, error) {
 
 // GetConfigByChallengeID returns challenge configuration by ID
 func (cc *ChallengesClient) GetConfigByChallengeID(challengeID int64) (*ChallengeConfigInfo, error) {
+	return cc.GetConfigByChallengeIDWithContext(context.Background(), challengeID)
+}
+
+// GetConfigByChallengeIDWithContext is like GetConfigByChallengeID but binds the request to the given context
+func (cc *ChallengesClient) GetConfigByChallengeIDWithContext(
+	ctx context.Context, challengeID int64,
+) (*ChallengeConfigInfo, error) {
 	logger := cc.logger().WithField("method", "GetConfigByChallengeID")
 	var challengeConfig *ChallengeConfigInfo
-	if err := cc.c.GetInto(
+	ctx = internal.WithEndpoint(ctx, endpointChallengesConfigByChallengeID)
+	if err := cc.c.GetIntoWithContext(
+		ctx,
 		fmt.Sprintf(endpointChallengesConfigByChallengeID, challengeID), &challengeConfig,
 	); err != nil {
 		logger.Debug(err)
@@ -57,6 +76,14 @@ func (cc *ChallengesClient) GetConfigByChallengeID(challengeID int64) (*Challeng
 // GetLeaderBoardByChallengeIDAndLevel returns top players for each level
 func (cc *ChallengesClient) GetLeaderBoardByChallengeIDAndLevel(
 	challengeID int64, tier tier, limit int32,
+) ([]*ApexPlayerInfo, error) {
+	return cc.GetLeaderBoardByChallengeIDAndLevelWithContext(context.Background(), challengeID, tier, limit)
+}
+
+// GetLeaderBoardByChallengeIDAndLevelWithContext is like GetLeaderBoardByChallengeIDAndLevel but binds the
+// request to the given context
+func (cc *ChallengesClient) GetLeaderBoardByChallengeIDAndLevelWithContext(
+	ctx context.Context, challengeID int64, tier tier, limit int32,
 ) ([]*ApexPlayerInfo, error) {
 	logger := cc.logger().WithField("method", "GetLeaderBoardByChallengeIDAndLevel")
 	var apexPlayerInfo []*ApexPlayerInfo
@@ -66,7 +93,9 @@ func (cc *ChallengesClient) GetLeaderBoardByChallengeIDAndLevel(
 	if limit <= 0 {
 		limit = 50
 	}
-	if err := cc.c.GetInto(
+	ctx = internal.WithEndpoint(ctx, endpointChallengesLeaderboards)
+	if err := cc.c.GetIntoWithContext(
+		ctx,
 		fmt.Sprintf(endpointChallengesLeaderboards, challengeID, tier, limit), &apexPlayerInfo,
 	); err != nil {
 		logger.Debug(err)
@@ -77,9 +106,18 @@ func (cc *ChallengesClient) GetLeaderBoardByChallengeIDAndLevel(
 
 // GetPercentilesByChallengeID returns map of level to percentiles of players who have achieved it for a challenge
 func (cc *ChallengesClient) GetPercentilesByChallengeID(challengeID int64) (Percentiles, error) {
+	return cc.GetPercentilesByChallengeIDWithContext(context.Background(), challengeID)
+}
+
+// GetPercentilesByChallengeIDWithContext is like GetPercentilesByChallengeID but binds the request to the given context
+func (cc *ChallengesClient) GetPercentilesByChallengeIDWithContext(
+	ctx context.Context, challengeID int64,
+) (Percentiles, error) {
 	logger := cc.logger().WithField("method", "GetPercentilesByChallengeID")
 	var percentiles Percentiles
-	if err := cc.c.GetInto(
+	ctx = internal.WithEndpoint(ctx, endpointChallengesPercentilesByChallengeID)
+	if err := cc.c.GetIntoWithContext(
+		ctx,
 		fmt.Sprintf(endpointChallengesPercentilesByChallengeID, challengeID), &percentiles,
 	); err != nil {
 		logger.Debug(err)
@@ -90,9 +128,17 @@ func (cc *ChallengesClient) GetPercentilesByChallengeID(challengeID int64) (Perc
 
 // GetPlayerDataByPUUID returns player information with list of all progressed challenges
 func (cc *ChallengesClient) GetPlayerDataByPUUID(uuid string) (*PlayerInfo, error) {
+	return cc.GetPlayerDataByPUUIDWithContext(context.Background(), uuid)
+}
+
+// GetPlayerDataByPUUIDWithContext is like GetPlayerDataByPUUID but binds the request to the given context
+func (cc *ChallengesClient) GetPlayerDataByPUUIDWithContext(ctx context.Context, uuid string) (*PlayerInfo, error) {
 	logger := cc.logger().WithField("method", "GetPlayerDataByPUUID")
 	var playerData *PlayerInfo
-	if err := cc.c.GetInto(fmt.Sprintf(endpointChallengesPlayerDataByPUUID, uuid), &playerData); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointChallengesPlayerDataByPUUID)
+	if err := cc.c.GetIntoWithContext(
+		ctx, fmt.Sprintf(endpointChallengesPlayerDataByPUUID, uuid), &playerData,
+	); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
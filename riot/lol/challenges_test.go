@@ -38,7 +38,7 @@ func TestChallengesClient_GetConfig(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&ChallengesClient{c: client}).GetConfig()
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -71,7 +71,7 @@ func TestChallengesClient_GetPercentiles(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&ChallengesClient{c: client}).GetPercentiles()
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -104,7 +104,7 @@ func TestChallengesClient_GetConfigWithChallengeId(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&ChallengesClient{c: client}).GetConfigByChallengeID(1)
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -137,7 +137,7 @@ func TestChallengesClient_GetLeaderBoardByChallengeIdAndLevel(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&ChallengesClient{c: client}).GetLeaderBoardByChallengeIDAndLevel(203102, "", 0)
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -170,7 +170,7 @@ func TestChallengesClient_GetPercentilesWithChallengeId(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&ChallengesClient{c: client}).GetPercentilesByChallengeID(1)
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -203,7 +203,7 @@ func TestChallengesClient_GetPlayerDataWithPUUID(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&ChallengesClient{c: client}).GetPlayerDataByPUUID("1")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
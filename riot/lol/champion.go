@@ -1,9 +1,10 @@
 package lol
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // ChampionClient provides methods for the champions endpoints of the League of Legends API.
@@ -13,9 +14,14 @@ type ChampionClient struct {
 
 // GetFreeRotation returns information about the current free champion rotation
 func (c *ChampionClient) GetFreeRotation() (*ChampionInfo, error) {
+	return c.GetFreeRotationWithContext(context.Background())
+}
+
+// GetFreeRotationWithContext is like GetFreeRotation but binds the request to the given context
+func (c *ChampionClient) GetFreeRotationWithContext(ctx context.Context) (*ChampionInfo, error) {
 	logger := c.logger().WithField("method", "GetFreeRotation")
 	var info *ChampionInfo
-	if err := c.c.GetInto(endpointGetFreeChampionRotation, &info); err != nil {
+	if err := c.c.GetIntoWithContext(ctx, endpointGetFreeChampionRotation, &info); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -1,11 +1,11 @@
 package lol
 
 import (
+	"context"
 	"fmt"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // ChampionMasteryClient provides methods for the champion mastery endpoints of the
@@ -14,11 +14,85 @@ type ChampionMasteryClient struct {
 	c *internal.Client
 }
 
+// ListByPUUID returns information about masteries for the player with the given PUUID
+func (c *ChampionMasteryClient) ListByPUUID(puuid string) ([]*ChampionMastery, error) {
+	return c.ListByPUUIDWithContext(context.Background(), puuid)
+}
+
+// ListByPUUIDWithContext is like ListByPUUID but binds the request to the given context
+func (c *ChampionMasteryClient) ListByPUUIDWithContext(ctx context.Context, puuid string) ([]*ChampionMastery, error) {
+	logger := c.logger().WithField("method", "ListByPUUID")
+	var masteries []*ChampionMastery
+	ctx = internal.WithEndpoint(ctx, endpointGetChampionMasteriesByPUUID)
+	if err := c.c.GetIntoWithContext(
+		ctx,
+		fmt.Sprintf(endpointGetChampionMasteriesByPUUID, puuid),
+		&masteries,
+	); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return masteries, nil
+}
+
+// GetByPUUID returns information about the mastery of the champion with the given ID the player with the
+// given PUUID has
+func (c *ChampionMasteryClient) GetByPUUID(puuid, championID string) (*ChampionMastery, error) {
+	return c.GetByPUUIDWithContext(context.Background(), puuid, championID)
+}
+
+// GetByPUUIDWithContext is like GetByPUUID but binds the request to the given context
+func (c *ChampionMasteryClient) GetByPUUIDWithContext(
+	ctx context.Context, puuid, championID string,
+) (*ChampionMastery, error) {
+	logger := c.logger().WithField("method", "GetByPUUID")
+	var mastery *ChampionMastery
+	ctx = internal.WithEndpoint(ctx, endpointGetChampionMasteryByPUUID)
+	if err := c.c.GetIntoWithContext(
+		ctx,
+		fmt.Sprintf(endpointGetChampionMasteryByPUUID, puuid, championID),
+		&mastery,
+	); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return mastery, nil
+}
+
+// GetTotalByPUUID returns the accumulated mastery score of all champions played by the player with the
+// given PUUID
+func (c *ChampionMasteryClient) GetTotalByPUUID(puuid string) (int, error) {
+	return c.GetTotalByPUUIDWithContext(context.Background(), puuid)
+}
+
+// GetTotalByPUUIDWithContext is like GetTotalByPUUID but binds the request to the given context
+func (c *ChampionMasteryClient) GetTotalByPUUIDWithContext(ctx context.Context, puuid string) (int, error) {
+	logger := c.logger().WithField("method", "GetTotalByPUUID")
+	var score int
+	ctx = internal.WithEndpoint(ctx, endpointGetChampionMasteryScoreByPUUID)
+	if err := c.c.GetIntoWithContext(
+		ctx, fmt.Sprintf(endpointGetChampionMasteryScoreByPUUID, puuid), &score,
+	); err != nil {
+		logger.Debug(err)
+		return 0, err
+	}
+	return score, nil
+}
+
 // List returns information about masteries for the summoner with the given ID
+//
+// Deprecated: Riot removed the summoner ID endpoints of champion-mastery-v4, use ListByPUUID instead
 func (c *ChampionMasteryClient) List(summonerID string) ([]*ChampionMastery, error) {
+	return c.ListWithContext(context.Background(), summonerID)
+}
+
+// ListWithContext is like List but binds the request to the given context
+func (c *ChampionMasteryClient) ListWithContext(ctx context.Context, summonerID string) ([]*ChampionMastery, error) {
 	logger := c.logger().WithField("method", "List")
 	var masteries []*ChampionMastery
-	if err := c.c.GetInto(
+	ctx = internal.WithEndpoint(ctx, endpointGetChampionMasteries)
+	if err := c.c.GetIntoWithContext(
+		ctx,
 		fmt.Sprintf(endpointGetChampionMasteries, summonerID),
 		&masteries,
 	); err != nil {
@@ -30,10 +104,21 @@ func (c *ChampionMasteryClient) List(summonerID string) ([]*ChampionMastery, err
 
 // Get returns information about the mastery of the champion with the given ID the summoner with the
 // given ID has
+//
+// Deprecated: Riot removed the summoner ID endpoints of champion-mastery-v4, use GetByPUUID instead
 func (c *ChampionMasteryClient) Get(summonerID, championID string) (*ChampionMastery, error) {
+	return c.GetWithContext(context.Background(), summonerID, championID)
+}
+
+// GetWithContext is like Get but binds the request to the given context
+func (c *ChampionMasteryClient) GetWithContext(
+	ctx context.Context, summonerID, championID string,
+) (*ChampionMastery, error) {
 	logger := c.logger().WithField("method", "Get")
 	var mastery *ChampionMastery
-	if err := c.c.GetInto(
+	ctx = internal.WithEndpoint(ctx, endpointGetChampionMastery)
+	if err := c.c.GetIntoWithContext(
+		ctx,
 		fmt.Sprintf(endpointGetChampionMastery, summonerID, championID),
 		&mastery,
 	); err != nil {
@@ -45,10 +130,20 @@ func (c *ChampionMasteryClient) Get(summonerID, championID string) (*ChampionMas
 
 // GetTotal returns the accumulated mastery score of all champions played by the summoner with the
 // given ID
+//
+// Deprecated: Riot removed the summoner ID endpoints of champion-mastery-v4, use GetTotalByPUUID instead
 func (c *ChampionMasteryClient) GetTotal(summonerID string) (int, error) {
+	return c.GetTotalWithContext(context.Background(), summonerID)
+}
+
+// GetTotalWithContext is like GetTotal but binds the request to the given context
+func (c *ChampionMasteryClient) GetTotalWithContext(ctx context.Context, summonerID string) (int, error) {
 	logger := c.logger().WithField("method", "GetTotal")
 	var score int
-	if err := c.c.GetInto(fmt.Sprintf(endpointGetChampionMasteryTotalScore, summonerID), &score); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetChampionMasteryTotalScore)
+	if err := c.c.GetIntoWithContext(
+		ctx, fmt.Sprintf(endpointGetChampionMasteryTotalScore, summonerID), &score,
+	); err != nil {
 		logger.Debug(err)
 		return 0, err
 	}
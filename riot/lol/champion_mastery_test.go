@@ -38,7 +38,7 @@ func TestChampionMasteryClient_List(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&ChampionMasteryClient{c: client}).List("id")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -71,7 +71,7 @@ func TestChampionMasteryClient_Get(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&ChampionMasteryClient{c: client}).Get("id", "id")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -104,7 +104,7 @@ func TestChampionMasteryClient_GetTotal(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&ChampionMasteryClient{c: client}).GetTotal("id")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -112,3 +112,64 @@ func TestChampionMasteryClient_GetTotal(t *testing.T) {
 		)
 	}
 }
+
+func TestChampionMasteryClient_ByPUUID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		call     func(c *ChampionMasteryClient) (interface{}, error)
+		response interface{}
+		wantPath string
+	}{
+		{
+			name: "list",
+			call: func(c *ChampionMasteryClient) (interface{}, error) {
+				return c.ListByPUUID("puuid")
+			},
+			response: []*ChampionMastery{{PUUID: "puuid", ChampionID: 22}},
+			wantPath: "/lol/champion-mastery/v4/champion-masteries/by-puuid/puuid",
+		},
+		{
+			name: "get",
+			call: func(c *ChampionMasteryClient) (interface{}, error) {
+				return c.GetByPUUID("puuid", "22")
+			},
+			response: &ChampionMastery{PUUID: "puuid", ChampionID: 22},
+			wantPath: "/lol/champion-mastery/v4/champion-masteries/by-puuid/puuid/by-champion/22",
+		},
+		{
+			name: "total",
+			call: func(c *ChampionMasteryClient) (interface{}, error) {
+				return c.GetTotalByPUUID("puuid")
+			},
+			response: 42,
+			wantPath: "/lol/champion-mastery/v4/scores/by-puuid/puuid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				doer := &mock.Doer{
+					Custom: func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, tt.wantPath, r.URL.Path)
+						return mock.NewJSONMockDoer(tt.response, 200).Do(r)
+					},
+				}
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+				got, err := tt.call(&ChampionMasteryClient{c: client})
+				require.NoError(t, err)
+				assert.Equal(t, tt.response, got)
+			},
+		)
+		t.Run(
+			tt.name+" not found", func(t *testing.T) {
+				client := internal.NewClient(
+					api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(http.StatusNotFound),
+					logrus.StandardLogger(),
+				)
+				_, err := tt.call(&ChampionMasteryClient{c: client})
+				require.ErrorIs(t, err, api.ErrNotFound)
+			},
+		)
+	}
+}
@@ -38,7 +38,7 @@ func TestChampionClient_GetFreeRotation(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&ChampionClient{c: client}).GetFreeRotation()
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -1,6 +1,9 @@
 package lol
 
-import "github.com/KnutZuidema/golio/internal"
+import (
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
 
 // Client pools all methods for endpoints of the League of Legends API.
 type Client struct {
@@ -14,6 +17,8 @@ type Client struct {
 	Summoner        *SummonerClient
 	ThirdPartyCode  *ThirdPartyCodeClient
 	Tournament      *TournamentClient
+
+	base *internal.Client
 }
 
 // NewClient returns a new instance of a League of Legends client.
@@ -29,5 +34,11 @@ func NewClient(base *internal.Client) *Client {
 		Spectator:       &SpectatorClient{c: base},
 		Tournament:      &TournamentClient{c: base},
 		ThirdPartyCode:  &ThirdPartyCodeClient{c: base},
+		base:            base,
 	}
 }
+
+// ForRegion returns a client for the given region sharing the HTTP client, rate limiter and cache
+func (c *Client) ForRegion(region api.Region) *Client {
+	return NewClient(c.base.WithRegion(region))
+}
@@ -0,0 +1,44 @@
+package lol
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestClient_ForRegion(t *testing.T) {
+	var hosts []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			hosts = append(hosts, r.URL.Host)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("{}")),
+			}, nil
+		},
+	}
+	c := NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+	korea := c.ForRegion(api.RegionKorea)
+	_, err := korea.League.GetChallenger(QueueRankedSolo)
+	require.Nil(t, err)
+	_, err = korea.Match.Get("KR_1")
+	require.Nil(t, err)
+	_, err = korea.Match.GetTimeline("KR_1")
+	require.Nil(t, err)
+	_, err = c.League.GetChallenger(QueueRankedSolo)
+	require.Nil(t, err)
+	assert.Equal(
+		t, []string{
+			"kr.api.riotgames.com", "asia.api.riotgames.com", "asia.api.riotgames.com", "euw1.api.riotgames.com",
+		}, hosts,
+	)
+}
@@ -7,6 +7,9 @@ const (
 	endpointGetChampionMasteries               = endpointMasteriesBase + "/by-summoner/%s"
 	endpointGetChampionMastery                 = endpointMasteriesBase + "/by-summoner/%s/by-champion/%s"
 	endpointGetChampionMasteryTotalScore       = endpointMasteryBase + "/scores/by-summoner/%s"
+	endpointGetChampionMasteriesByPUUID        = endpointMasteriesBase + "/by-puuid/%s"
+	endpointGetChampionMasteryByPUUID          = endpointMasteriesBase + "/by-puuid/%s/by-champion/%s"
+	endpointGetChampionMasteryScoreByPUUID     = endpointMasteryBase + "/scores/by-puuid/%s"
 	endpointChallengesBase                     = endpointBase + "/challenges/v1"
 	endpointChallengesBaseChallenges           = endpointChallengesBase + "/challenges"
 	endpointChallengesConfig                   = endpointChallengesBaseChallenges + "/config"
@@ -23,6 +26,7 @@ const (
 	endpointGetGrandmasterLeague               = endpointLeagueBase + "/grandmasterleagues/by-queue/%s"
 	endpointGetMasterLeague                    = endpointLeagueBase + "/masterleagues/by-queue/%s"
 	endpointGetLeaguesBySummoner               = endpointLeagueBase + "/entries/by-summoner/%s"
+	endpointGetLeaguesByPUUID                  = endpointLeagueBase + "/entries/by-puuid/%s"
 	endpointGetLeagues                         = endpointLeagueBase + "/entries/%s/%s/%s"
 	endpointGetLeague                          = endpointLeagueBase + "/leagues/%s"
 	endpointStatusBase                         = endpointBase + "/status/v3"
@@ -35,9 +39,13 @@ const (
 	endpointSummonerBase                       = endpointBase + "/summoner/v4"
 	endpointGetSummonerBySummonerID            = endpointSummonerBase + "/summoners/%s"
 	endpointGetSummonerBy                      = endpointSummonerBase + "/summoners/by-%s/%s"
+	endpointGetSummonerMe                      = endpointSummonerBase + "/summoners/me"
+	endpointGetSummonerByRSOPUUID              = "/fulfillment/v1/summoners/by-puuid/%s"
 	endpointSpectatorBase                      = endpointBase + "/spectator/v4"
 	endpointGetCurrentGame                     = endpointSpectatorBase + "/active-games/by-summoner/%s"
-	endpointGetFeaturedGames                   = endpointSpectatorBase + "/featured-games"
+	endpointSpectatorV5Base                    = endpointBase + "/spectator/v5"
+	endpointGetCurrentGameByPUUID              = endpointSpectatorV5Base + "/active-games/by-summoner/%s"
+	endpointGetFeaturedGames                   = endpointSpectatorV5Base + "/featured-games"
 	endpointTournamentStubBase                 = endpointBase + "/tournament-stub/v4"
 	endpointCreateStubTournamentCodes          = endpointTournamentStubBase + "/codes?count=%d&tournamentId=%d"
 	endpointGetStubLobbyEvents                 = endpointTournamentStubBase + "/lobby-events/by-code/%s"
@@ -71,6 +79,9 @@ const (
 	QueueRankedTwistedTreeline       = "RANKED_FLEX_TT"
 )
 
+// GameModeArena is the game mode of Arena matches, which are played by subteams of two players
+const GameModeArena = "CHERRY"
+
 type tier string
 
 // All possible Tiers
@@ -87,6 +98,16 @@ const (
 	TierChallenger       = "CHALLENGER"
 )
 
+// IsApex returns whether the tier is one of the ApexTiers, which are not divided into divisions
+func (t tier) IsApex() bool {
+	for _, apex := range ApexTiers {
+		if t == apex {
+			return true
+		}
+	}
+	return false
+}
+
 type division string
 
 // All possible divisions
@@ -105,7 +126,7 @@ var (
 		QueueRankedTwistedTreeline,
 	}
 
-	// Tiers is a list of all available tiers
+	// Tiers is a list of all available tiers, ordered from lowest to highest
 	Tiers = []tier{
 		TierIron,
 		TierBronze,
@@ -114,6 +135,16 @@ var (
 		TierPlatinum,
 		TierEmerald,
 		TierDiamond,
+		TierMaster,
+		TierGrandMaster,
+		TierChallenger,
+	}
+
+	// ApexTiers is a list of the tiers without divisions, ordered from lowest to highest
+	ApexTiers = []tier{
+		TierMaster,
+		TierGrandMaster,
+		TierChallenger,
 	}
 
 	// Divisions is a list of all available divisions
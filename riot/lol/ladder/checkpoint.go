@@ -0,0 +1,34 @@
+package ladder
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// LoadCheckpoint reads a checkpoint written by SaveCheckpoint. It returns nil if the file does not exist.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var checkpoint *Checkpoint
+	if err := json.Unmarshal(content, &checkpoint); err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+// SaveCheckpoint writes the checkpoint to the file at path, replacing any previous checkpoint atomically
+func SaveCheckpoint(path string, checkpoint *Checkpoint) error {
+	content, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	return internal.WriteFileAtomic(path, content)
+}
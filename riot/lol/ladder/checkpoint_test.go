@@ -0,0 +1,37 @@
+package ladder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointFile(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	checkpoint, err := LoadCheckpoint(path)
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	want := &Checkpoint{
+		Queue:     "RANKED_SOLO_5x5",
+		StartedAt: time.Unix(100, 0).UTC(),
+		Tier:      "GOLD",
+		Division:  "II",
+		Page:      3,
+		Buckets:   []Bucket{{Tier: "CHALLENGER", Count: 300}},
+	}
+	require.NoError(t, SaveCheckpoint(path, want))
+	checkpoint, err = LoadCheckpoint(path)
+	require.NoError(t, err)
+	assert.Equal(t, want, checkpoint)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = LoadCheckpoint(path)
+	assert.Error(t, err)
+	require.Error(t, SaveCheckpoint(filepath.Join(path, "missing", "checkpoint.json"), want))
+}
@@ -0,0 +1,250 @@
+// Package ladder exports every ranked player of a queue by crawling all tiers and divisions of the league-v4 API.
+// The crawl is checkpointed after every page, so an interrupted crawl can be resumed where it stopped.
+package ladder
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+var (
+	// ErrUnknownQueue is returned when crawling a queue which is not one of lol.Queues
+	ErrUnknownQueue = errors.New("unknown queue")
+	// ErrCheckpointMismatch is returned when resuming from a checkpoint of another queue or an unknown segment
+	ErrCheckpointMismatch = errors.New("checkpoint does not match crawl")
+)
+
+// Bucket holds the number of players in a tier and division. The division is empty for apex tiers.
+type Bucket struct {
+	Tier     string `json:"tier"`
+	Division string `json:"division,omitempty"`
+	Count    int    `json:"count"`
+	// Percentile is the percentage of players ranked below the bucket, which is the percentile boundary of its
+	// lowest player
+	Percentile float64 `json:"percentile"`
+}
+
+// Snapshot summarizes a crawled ladder
+type Snapshot struct {
+	Queue      string    `json:"queue"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	Total      int       `json:"total"`
+	// Buckets are ordered from the lowest to the highest tier and division
+	Buckets []Bucket `json:"buckets"`
+}
+
+// Checkpoint is the progress of a crawl. Every page of entries is written before the checkpoint moves past it,
+// so resuming may repeat the entries of at most one page.
+type Checkpoint struct {
+	Queue     string    `json:"queue"`
+	StartedAt time.Time `json:"startedAt"`
+	// Tier, Division and Page identify the next page to request
+	Tier     string `json:"tier"`
+	Division string `json:"division,omitempty"`
+	Page     int    `json:"page"`
+	// Done is set once all segments were crawled
+	Done bool `json:"done"`
+	// Buckets holds the counts of all segments crawled so far in crawl order
+	Buckets []Bucket `json:"buckets"`
+}
+
+// Options configure a Crawler
+type Options struct {
+	// Checkpoint is called with the progress after every page written. Returning an error stops the crawl.
+	Checkpoint func(ctx context.Context, checkpoint *Checkpoint) error
+}
+
+// Crawler exports the ladder of a queue
+type Crawler struct {
+	client  *lol.LeagueClient
+	options Options
+	now     func() time.Time
+}
+
+// New returns a Crawler requesting the ladder with the client
+func New(client *lol.LeagueClient, options *Options) *Crawler {
+	c := &Crawler{
+		client: client,
+		now:    time.Now,
+	}
+	if options != nil {
+		c.options = *options
+	}
+	return c
+}
+
+// segment is a tier and division of the ladder with an iterator over its entries starting at a page
+type segment struct {
+	tier     string
+	division string
+	iterate  func(ctx context.Context, page int) *lol.LeagueEntryIterator
+}
+
+// Crawl writes every entry of the queue to the writer, starting with the highest tier. If checkpoint is not nil
+// the crawl resumes from it. The returned snapshot counts the entries per tier and division.
+//
+// The ladder changes while it is crawled, so players moving between pages may be written twice or not at all.
+func (c *Crawler) Crawl(ctx context.Context, queue string, w Writer, checkpoint *Checkpoint) (*Snapshot, error) {
+	segments, err := c.segments(queue)
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{
+			Queue:     queue,
+			StartedAt: c.now(),
+			Tier:      segments[0].tier,
+			Division:  segments[0].division,
+			Page:      1,
+		}
+	}
+	current, err := resume(segments, queue, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	for ; current < len(segments); current++ {
+		if err := c.crawlSegment(ctx, segments, current, w, checkpoint); err != nil {
+			return nil, err
+		}
+	}
+	return c.snapshot(checkpoint), nil
+}
+
+func (c *Crawler) crawlSegment(
+	ctx context.Context, segments []segment, current int, w Writer, checkpoint *Checkpoint,
+) error {
+	seg := segments[current]
+	if len(checkpoint.Buckets) <= current {
+		checkpoint.Buckets = append(checkpoint.Buckets, Bucket{Tier: seg.tier, Division: seg.division})
+	}
+	// entries written since the last checkpoint are only counted once the checkpoint moves past them
+	pending := 0
+	it := seg.iterate(ctx, checkpoint.Page)
+	for it.Next() {
+		if it.Page() != checkpoint.Page {
+			checkpoint.Buckets[current].Count += pending
+			pending = 0
+			checkpoint.Page = it.Page()
+			if err := c.commit(ctx, w, checkpoint); err != nil {
+				return err
+			}
+		}
+		if err := w.Write(it.Entry()); err != nil {
+			return err
+		}
+		pending++
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	checkpoint.Buckets[current].Count += pending
+	checkpoint.advance(segments, current)
+	return c.commit(ctx, w, checkpoint)
+}
+
+// commit flushes the writer and passes the checkpoint on
+func (c *Crawler) commit(ctx context.Context, w Writer, checkpoint *Checkpoint) error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if c.options.Checkpoint != nil {
+		return c.options.Checkpoint(ctx, checkpoint)
+	}
+	return nil
+}
+
+// advance moves the checkpoint to the segment after the current one
+func (cp *Checkpoint) advance(segments []segment, current int) {
+	cp.Page = 1
+	if current+1 == len(segments) {
+		cp.Tier, cp.Division, cp.Done = "", "", true
+		return
+	}
+	cp.Tier, cp.Division = segments[current+1].tier, segments[current+1].division
+}
+
+// resume returns the index of the segment the checkpoint continues at. The checkpoint must hold the buckets of
+// all segments before it and may hold the bucket of the segment itself.
+func resume(segments []segment, queue string, checkpoint *Checkpoint) (int, error) {
+	if checkpoint.Queue != queue {
+		return 0, ErrCheckpointMismatch
+	}
+	if checkpoint.Done {
+		if len(checkpoint.Buckets) != len(segments) {
+			return 0, ErrCheckpointMismatch
+		}
+		return len(segments), nil
+	}
+	for i, seg := range segments {
+		if seg.tier == checkpoint.Tier && seg.division == checkpoint.Division {
+			if len(checkpoint.Buckets) != i && len(checkpoint.Buckets) != i+1 {
+				return 0, ErrCheckpointMismatch
+			}
+			return i, nil
+		}
+	}
+	return 0, ErrCheckpointMismatch
+}
+
+// segments returns the segments of the queue from the highest to the lowest
+func (c *Crawler) segments(name string) ([]segment, error) {
+	for _, queue := range lol.Queues {
+		if string(queue) != name {
+			continue
+		}
+		var segments []segment
+		for i := len(lol.Tiers) - 1; i >= 0; i-- {
+			tier := lol.Tiers[i]
+			divisions := lol.Divisions
+			if tier.IsApex() {
+				divisions = lol.Divisions[:1]
+			}
+			for _, division := range divisions {
+				division := division
+				name := string(division)
+				if tier.IsApex() {
+					name = ""
+				}
+				segments = append(segments, segment{
+					tier:     string(tier),
+					division: name,
+					iterate: func(ctx context.Context, page int) *lol.LeagueEntryIterator {
+						return c.client.IterateEntriesWithContext(
+							ctx, queue, tier, division, &lol.LeagueEntriesOptions{Page: page},
+						)
+					},
+				})
+			}
+		}
+		return segments, nil
+	}
+	return nil, ErrUnknownQueue
+}
+
+// snapshot returns the snapshot of a finished crawl
+func (c *Crawler) snapshot(checkpoint *Checkpoint) *Snapshot {
+	snapshot := &Snapshot{
+		Queue:      checkpoint.Queue,
+		StartedAt:  checkpoint.StartedAt,
+		FinishedAt: c.now(),
+		Buckets:    make([]Bucket, 0, len(checkpoint.Buckets)),
+	}
+	for _, bucket := range checkpoint.Buckets {
+		snapshot.Total += bucket.Count
+	}
+	below := 0
+	for i := len(checkpoint.Buckets) - 1; i >= 0; i-- {
+		bucket := checkpoint.Buckets[i]
+		bucket.Percentile = 0
+		if snapshot.Total > 0 {
+			bucket.Percentile = float64(below) / float64(snapshot.Total) * 100
+		}
+		below += bucket.Count
+		snapshot.Buckets = append(snapshot.Buckets, bucket)
+	}
+	return snapshot
+}
@@ -0,0 +1,224 @@
+package ladder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// ladderDoer serves a ladder with two Challenger players, one Grandmaster, no Master and three Gold I players on
+// pages of two entries
+func ladderDoer(requests *int) internal.Doer {
+	leagues := map[string]lol.LeagueList{
+		"challengerleagues": {LeagueID: "c", Tier: "CHALLENGER", Queue: "RANKED_SOLO_5x5", Entries: []*lol.LeagueItem{
+			{PUUID: "c2", LeaguePoints: 1000}, {PUUID: "c1", LeaguePoints: 1500},
+		}},
+		"grandmasterleagues": {LeagueID: "g", Tier: "GRANDMASTER", Queue: "RANKED_SOLO_5x5", Entries: []*lol.LeagueItem{
+			{PUUID: "g1", LeaguePoints: 500},
+		}},
+		"masterleagues": {LeagueID: "m", Tier: "MASTER", Queue: "RANKED_SOLO_5x5", Entries: []*lol.LeagueItem{}},
+	}
+	gold := []*lol.LeagueItem{
+		{PUUID: "p1", Tier: "GOLD", Rank: "I"}, {PUUID: "p2", Tier: "GOLD", Rank: "I"},
+		{PUUID: "p3", Tier: "GOLD", Rank: "I"},
+	}
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			*requests++
+			parts := strings.Split(r.URL.Path, "/")
+			if list, ok := leagues[parts[4]]; ok {
+				return mock.NewJSONMockDoer(list, 200).Do(r)
+			}
+			entries := []*lol.LeagueItem{}
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			if parts[6] == "GOLD" && parts[7] == "I" && page <= 2 {
+				end := page * 2
+				if end > len(gold) {
+					end = len(gold)
+				}
+				entries = gold[(page-1)*2 : end]
+			}
+			return mock.NewJSONMockDoer(entries, 200).Do(r)
+		},
+	}
+}
+
+func newCrawler(requests *int, options *Options) *Crawler {
+	client := lol.NewClient(
+		internal.NewClient(api.RegionEuropeWest, "API_KEY", ladderDoer(requests), logrus.StandardLogger()),
+	)
+	c := New(client.League, options)
+	c.now = func() time.Time { return time.Unix(0, 0).UTC() }
+	return c
+}
+
+func puuids(t *testing.T, output string) []string {
+	var res []string
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		var entry lol.LeagueItem
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		res = append(res, entry.PUUID)
+	}
+	return res
+}
+
+func TestCrawler_Crawl(t *testing.T) {
+	t.Parallel()
+	var requests int
+	var checkpoints int
+	c := newCrawler(&requests, &Options{
+		Checkpoint: func(_ context.Context, _ *Checkpoint) error {
+			checkpoints++
+			return nil
+		},
+	})
+	var output bytes.Buffer
+	snapshot, err := c.Crawl(context.Background(), string(lol.QueueRankedSolo), NewJSONLinesWriter(&output), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c1", "c2", "g1", "p1", "p2", "p3"}, puuids(t, output.String()))
+	// 3 apex leagues, 28 divisions and 2 additional pages for Gold I
+	assert.Equal(t, 33, requests)
+	// 31 finished segments and 1 additional page for Gold I
+	assert.Equal(t, 32, checkpoints)
+	assert.Equal(t, "RANKED_SOLO_5x5", snapshot.Queue)
+	assert.Equal(t, 6, snapshot.Total)
+	require.Len(t, snapshot.Buckets, 31)
+	assert.Equal(t, Bucket{Tier: "IRON", Division: "IV"}, snapshot.Buckets[0])
+	assert.Equal(t, Bucket{Tier: "GOLD", Division: "II"}, snapshot.Buckets[14])
+	assert.Equal(t, Bucket{Tier: "GOLD", Division: "I", Count: 3}, snapshot.Buckets[15])
+	assert.Equal(t, Bucket{Tier: "PLATINUM", Division: "IV", Percentile: 50}, snapshot.Buckets[16])
+	assert.Equal(t, Bucket{Tier: "MASTER", Percentile: 50}, snapshot.Buckets[28])
+	assert.Equal(t, Bucket{Tier: "GRANDMASTER", Count: 1, Percentile: 50}, snapshot.Buckets[29])
+	assert.InDelta(t, 100.0*4/6, snapshot.Buckets[30].Percentile, 1e-9)
+}
+
+func TestCrawler_CrawlResume(t *testing.T) {
+	t.Parallel()
+	var saved *Checkpoint
+	errInterrupted := errors.New("interrupted")
+	var requests int
+	c := newCrawler(&requests, &Options{
+		Checkpoint: func(_ context.Context, checkpoint *Checkpoint) error {
+			content, err := json.Marshal(checkpoint)
+			require.NoError(t, err)
+			saved = nil
+			require.NoError(t, json.Unmarshal(content, &saved))
+			if checkpoint.Tier == "GOLD" && checkpoint.Page == 2 {
+				return errInterrupted
+			}
+			return nil
+		},
+	})
+	var output bytes.Buffer
+	w := NewJSONLinesWriter(&output)
+	_, err := c.Crawl(context.Background(), string(lol.QueueRankedSolo), w, nil)
+	require.ErrorIs(t, err, errInterrupted)
+	assert.Equal(t, &Checkpoint{
+		Queue:     "RANKED_SOLO_5x5",
+		StartedAt: time.Unix(0, 0).UTC(),
+		Tier:      "GOLD",
+		Division:  "I",
+		Page:      2,
+		Buckets: []Bucket{
+			{Tier: "CHALLENGER", Count: 2}, {Tier: "GRANDMASTER", Count: 1}, {Tier: "MASTER"},
+			{Tier: "DIAMOND", Division: "I"}, {Tier: "DIAMOND", Division: "II"},
+			{Tier: "DIAMOND", Division: "III"}, {Tier: "DIAMOND", Division: "IV"},
+			{Tier: "EMERALD", Division: "I"}, {Tier: "EMERALD", Division: "II"},
+			{Tier: "EMERALD", Division: "III"}, {Tier: "EMERALD", Division: "IV"},
+			{Tier: "PLATINUM", Division: "I"}, {Tier: "PLATINUM", Division: "II"},
+			{Tier: "PLATINUM", Division: "III"}, {Tier: "PLATINUM", Division: "IV"},
+			{Tier: "GOLD", Division: "I", Count: 2},
+		},
+	}, saved)
+
+	c.options.Checkpoint = nil
+	requests = 0
+	snapshot, err := c.Crawl(context.Background(), string(lol.QueueRankedSolo), w, saved)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c1", "c2", "g1", "p1", "p2", "p3"}, puuids(t, output.String()))
+	// 2 pages of Gold I and 15 remaining divisions
+	assert.Equal(t, 17, requests)
+	assert.Equal(t, 6, snapshot.Total)
+	assert.Equal(t, Bucket{Tier: "GOLD", Division: "I", Count: 3}, snapshot.Buckets[15])
+}
+
+func TestCrawler_CrawlErrors(t *testing.T) {
+	t.Parallel()
+	var requests int
+	tests := []struct {
+		name       string
+		queue      string
+		checkpoint *Checkpoint
+		want       error
+	}{
+		{name: "unknown queue", queue: "UNKNOWN", want: ErrUnknownQueue},
+		{
+			name:       "other queue",
+			queue:      string(lol.QueueRankedSolo),
+			checkpoint: &Checkpoint{Queue: lol.QueueRankedFlex, Tier: "GOLD", Division: "I", Page: 1},
+			want:       ErrCheckpointMismatch,
+		},
+		{
+			name:       "unknown segment",
+			queue:      string(lol.QueueRankedSolo),
+			checkpoint: &Checkpoint{Queue: string(lol.QueueRankedSolo), Tier: "WOOD", Page: 1},
+			want:       ErrCheckpointMismatch,
+		},
+		{
+			name:       "missing buckets",
+			queue:      string(lol.QueueRankedSolo),
+			checkpoint: &Checkpoint{Queue: string(lol.QueueRankedSolo), Tier: "GOLD", Division: "I", Page: 1},
+			want:       ErrCheckpointMismatch,
+		},
+		{
+			name:  "too many buckets",
+			queue: string(lol.QueueRankedSolo),
+			checkpoint: &Checkpoint{
+				Queue: string(lol.QueueRankedSolo), Tier: "CHALLENGER", Page: 1,
+				Buckets: []Bucket{{Tier: "CHALLENGER"}, {Tier: "GRANDMASTER"}},
+			},
+			want: ErrCheckpointMismatch,
+		},
+		{
+			name:       "missing buckets when done",
+			queue:      string(lol.QueueRankedSolo),
+			checkpoint: &Checkpoint{Queue: string(lol.QueueRankedSolo), Done: true},
+			want:       ErrCheckpointMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newCrawler(&requests, nil).Crawl(
+				context.Background(), tt.queue, NewJSONLinesWriter(&bytes.Buffer{}), tt.checkpoint,
+			)
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+	assert.Equal(t, 0, requests)
+}
+
+func TestCrawler_CrawlRequestError(t *testing.T) {
+	t.Parallel()
+	client := lol.NewClient(internal.NewClient(
+		api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(http.StatusForbidden), logrus.StandardLogger(),
+	))
+	_, err := New(client.League, nil).Crawl(
+		context.Background(), string(lol.QueueRankedSolo), NewJSONLinesWriter(&bytes.Buffer{}), nil,
+	)
+	require.ErrorIs(t, err, api.ErrForbidden)
+}
@@ -0,0 +1,85 @@
+package ladder
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// Writer receives the entries of a crawl. Flush is called after every page, before the checkpoint moves past it.
+type Writer interface {
+	Write(entry *lol.LeagueItem) error
+	Flush() error
+}
+
+// JSONLinesWriter writes every entry as a JSON object on its own line
+type JSONLinesWriter struct {
+	encoder *json.Encoder
+}
+
+// NewJSONLinesWriter returns a writer writing JSON Lines to w
+func NewJSONLinesWriter(w io.Writer) *JSONLinesWriter {
+	return &JSONLinesWriter{encoder: json.NewEncoder(w)}
+}
+
+// Write implements the Writer interface
+func (w *JSONLinesWriter) Write(entry *lol.LeagueItem) error {
+	return w.encoder.Encode(entry)
+}
+
+// Flush implements the Writer interface. Entries are written immediately, so there is nothing to flush.
+func (w *JSONLinesWriter) Flush() error {
+	return nil
+}
+
+// CSVHeader holds the columns written by a CSVWriter
+var CSVHeader = []string{
+	"queueType", "tier", "rank", "puuid", "summonerId", "leagueId", "leaguePoints", "wins", "losses",
+	"hotStreak", "veteran", "freshBlood", "inactive",
+}
+
+// CSVWriter writes every entry as a CSV record with the columns of CSVHeader
+type CSVWriter struct {
+	writer *csv.Writer
+	header bool
+}
+
+// NewCSVWriter returns a writer writing CSV to w. If header is true, CSVHeader is written before the first
+// entry, which should be omitted when appending to the output of an interrupted crawl.
+func NewCSVWriter(w io.Writer, header bool) *CSVWriter {
+	return &CSVWriter{writer: csv.NewWriter(w), header: header}
+}
+
+// Write implements the Writer interface
+func (w *CSVWriter) Write(entry *lol.LeagueItem) error {
+	if w.header {
+		if err := w.writer.Write(CSVHeader); err != nil {
+			return err
+		}
+		w.header = false
+	}
+	return w.writer.Write([]string{
+		entry.QueueType,
+		entry.Tier,
+		entry.Rank,
+		entry.PUUID,
+		entry.SummonerID,
+		entry.LeagueID,
+		strconv.Itoa(entry.LeaguePoints),
+		strconv.Itoa(entry.Wins),
+		strconv.Itoa(entry.Losses),
+		strconv.FormatBool(entry.HotStreak),
+		strconv.FormatBool(entry.Veteran),
+		strconv.FormatBool(entry.FreshBlood),
+		strconv.FormatBool(entry.Inactive),
+	})
+}
+
+// Flush implements the Writer interface
+func (w *CSVWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
@@ -0,0 +1,63 @@
+package ladder
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+func TestWriters(t *testing.T) {
+	t.Parallel()
+	entries := []*lol.LeagueItem{
+		{
+			QueueType: "RANKED_SOLO_5x5", Tier: "GOLD", Rank: "I", PUUID: "p1", SummonerID: "s1", LeagueID: "l",
+			LeaguePoints: 75, Wins: 10, Losses: 8, HotStreak: true,
+		},
+		{QueueType: "RANKED_SOLO_5x5", Tier: "GOLD", Rank: "II", PUUID: "p2", Inactive: true},
+	}
+	tests := []struct {
+		name   string
+		writer func(buf *bytes.Buffer) Writer
+		want   string
+	}{
+		{
+			name:   "json lines",
+			writer: func(buf *bytes.Buffer) Writer { return NewJSONLinesWriter(buf) },
+			want: `{"leagueId":"l","puuid":"p1","queueType":"RANKED_SOLO_5x5","summonerName":"","hotStreak":true,` +
+				`"miniSeries":null,"wins":10,"veteran":false,"losses":8,"freshBlood":false,"inactive":false,` +
+				`"tier":"GOLD","rank":"I","summonerId":"s1","leaguePoints":75}` + "\n" +
+				`{"leagueId":"","puuid":"p2","queueType":"RANKED_SOLO_5x5","summonerName":"","hotStreak":false,` +
+				`"miniSeries":null,"wins":0,"veteran":false,"losses":0,"freshBlood":false,"inactive":true,` +
+				`"tier":"GOLD","rank":"II","summonerId":"","leaguePoints":0}` + "\n",
+		},
+		{
+			name:   "csv",
+			writer: func(buf *bytes.Buffer) Writer { return NewCSVWriter(buf, true) },
+			want: "queueType,tier,rank,puuid,summonerId,leagueId,leaguePoints,wins,losses,hotStreak,veteran," +
+				"freshBlood,inactive\n" +
+				"RANKED_SOLO_5x5,GOLD,I,p1,s1,l,75,10,8,true,false,false,false\n" +
+				"RANKED_SOLO_5x5,GOLD,II,p2,,,0,0,0,false,false,false,true\n",
+		},
+		{
+			name:   "csv without header",
+			writer: func(buf *bytes.Buffer) Writer { return NewCSVWriter(buf, false) },
+			want: "RANKED_SOLO_5x5,GOLD,I,p1,s1,l,75,10,8,true,false,false,false\n" +
+				"RANKED_SOLO_5x5,GOLD,II,p2,,,0,0,0,false,false,false,true\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := tt.writer(&buf)
+			for _, entry := range entries {
+				require.NoError(t, w.Write(entry))
+			}
+			require.NoError(t, w.Flush())
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
@@ -1,11 +1,11 @@
 package lol
 
 import (
+	"context"
 	"fmt"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // LeagueClient provides methods for league endpoints of the League of Legends API.
@@ -15,9 +15,15 @@ type LeagueClient struct {
 
 // GetChallenger returns the current Challenger league for the Region
 func (l *LeagueClient) GetChallenger(queue queue) (*LeagueList, error) {
+	return l.GetChallengerWithContext(context.Background(), queue)
+}
+
+// GetChallengerWithContext is like GetChallenger but binds the request to the given context
+func (l *LeagueClient) GetChallengerWithContext(ctx context.Context, queue queue) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "GetChallenger")
 	var list *LeagueList
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetChallengerLeague, queue), &list); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetChallengerLeague)
+	if err := l.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetChallengerLeague, queue), &list); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -26,9 +32,15 @@ func (l *LeagueClient) GetChallenger(queue queue) (*LeagueList, error) {
 
 // GetGrandmaster returns the current Grandmaster league for the Region
 func (l *LeagueClient) GetGrandmaster(queue queue) (*LeagueList, error) {
+	return l.GetGrandmasterWithContext(context.Background(), queue)
+}
+
+// GetGrandmasterWithContext is like GetGrandmaster but binds the request to the given context
+func (l *LeagueClient) GetGrandmasterWithContext(ctx context.Context, queue queue) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "GetGrandmaster")
 	var list *LeagueList
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetGrandmasterLeague, queue), &list); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetGrandmasterLeague)
+	if err := l.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetGrandmasterLeague, queue), &list); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -37,31 +49,81 @@ func (l *LeagueClient) GetGrandmaster(queue queue) (*LeagueList, error) {
 
 // GetMaster returns the current Master league for the Region
 func (l *LeagueClient) GetMaster(queue queue) (*LeagueList, error) {
+	return l.GetMasterWithContext(context.Background(), queue)
+}
+
+// GetMasterWithContext is like GetMaster but binds the request to the given context
+func (l *LeagueClient) GetMasterWithContext(ctx context.Context, queue queue) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "GetMaster")
 	var list *LeagueList
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetMasterLeague, queue), &list); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetMasterLeague)
+	if err := l.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetMasterLeague, queue), &list); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
 	return list, nil
 }
 
+// ListByPUUID returns all leagues the player with the given PUUID is in
+func (l *LeagueClient) ListByPUUID(puuid string) ([]*LeagueItem, error) {
+	return l.ListByPUUIDWithContext(context.Background(), puuid)
+}
+
+// ListByPUUIDWithContext is like ListByPUUID but binds the request to the given context
+func (l *LeagueClient) ListByPUUIDWithContext(ctx context.Context, puuid string) ([]*LeagueItem, error) {
+	logger := l.logger().WithField("method", "ListByPUUID")
+	var leagues []*LeagueItem
+	ctx = internal.WithEndpoint(ctx, endpointGetLeaguesByPUUID)
+	if err := l.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetLeaguesByPUUID, puuid), &leagues); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return leagues, nil
+}
+
 // ListBySummoner returns all leagues a summoner with the given ID is in
 func (l *LeagueClient) ListBySummoner(summonerID string) ([]*LeagueItem, error) {
+	return l.ListBySummonerWithContext(context.Background(), summonerID)
+}
+
+// ListBySummonerWithContext is like ListBySummoner but binds the request to the given context
+func (l *LeagueClient) ListBySummonerWithContext(ctx context.Context, summonerID string) ([]*LeagueItem, error) {
 	logger := l.logger().WithField("method", "ListBySummoner")
 	var leagues []*LeagueItem
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetLeaguesBySummoner, summonerID), &leagues); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetLeaguesBySummoner)
+	if err := l.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetLeaguesBySummoner, summonerID), &leagues); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
 	return leagues, nil
 }
 
-// ListPlayers returns all players with a league specified by its queue, tier and division
-func (l *LeagueClient) ListPlayers(queue queue, tier tier, division division) ([]*LeagueItem, error) {
+// LeagueEntriesOptions providing additional options for ListPlayers
+type LeagueEntriesOptions struct {
+	// Page is the page of the entries to return, starting at 1
+	Page int
+}
+
+// ListPlayers returns the players with a league specified by its queue, tier and division. Only the first page
+// of entries is returned unless a page is given, use IterateEntries to get all entries.
+func (l *LeagueClient) ListPlayers(
+	queue queue, tier tier, division division, options ...*LeagueEntriesOptions,
+) ([]*LeagueItem, error) {
+	return l.ListPlayersWithContext(context.Background(), queue, tier, division, options...)
+}
+
+// ListPlayersWithContext is like ListPlayers but binds the request to the given context
+func (l *LeagueClient) ListPlayersWithContext(
+	ctx context.Context, queue queue, tier tier, division division, options ...*LeagueEntriesOptions,
+) ([]*LeagueItem, error) {
 	logger := l.logger().WithField("method", "ListPlayers")
 	var leagues []*LeagueItem
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetLeagues, queue, tier, division), &leagues); err != nil {
+	endpoint := fmt.Sprintf(endpointGetLeagues, queue, tier, division)
+	if len(options) != 0 && options[0] != nil && options[0].Page > 0 {
+		endpoint += fmt.Sprintf("?page=%d", options[0].Page)
+	}
+	ctx = internal.WithEndpoint(ctx, endpointGetLeagues)
+	if err := l.c.GetIntoWithContext(ctx, endpoint, &leagues); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -70,9 +132,15 @@ func (l *LeagueClient) ListPlayers(queue queue, tier tier, division division) ([
 
 // Get returns a ranked league with the specified ID
 func (l *LeagueClient) Get(leagueID string) (*LeagueList, error) {
+	return l.GetWithContext(context.Background(), leagueID)
+}
+
+// GetWithContext is like Get but binds the request to the given context
+func (l *LeagueClient) GetWithContext(ctx context.Context, leagueID string) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "Get")
 	var leagues *LeagueList
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetLeague, leagueID), &leagues); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetLeague)
+	if err := l.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetLeague, leagueID), &leagues); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
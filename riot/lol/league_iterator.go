@@ -0,0 +1,137 @@
+package lol
+
+import (
+	"context"
+)
+
+// LeagueEntryIterator iterates over all entries of a queue, tier and division, requesting pages as they are
+// needed. Entries of the apex tiers are taken from the league of the tier, ordered by league points.
+//
+//	it := client.League.IterateEntries(lol.QueueRankedSolo, lol.TierGold, lol.DivisionOne)
+//	for it.Next() {
+//		fmt.Println(it.Entry().PUUID)
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type LeagueEntryIterator struct {
+	client   *LeagueClient
+	ctx      context.Context
+	queue    queue
+	tier     tier
+	division division
+
+	page    []*LeagueItem
+	number  int
+	pos     int
+	next    int
+	current *LeagueItem
+	err     error
+	done    bool
+}
+
+// IterateEntries returns an iterator over all entries of the given queue, tier and division. The division is
+// ignored for apex tiers. If a page is given in the options, the iteration starts at that page. All entries of an
+// apex tier are on the first page, so starting an apex tier at a later page yields no entries.
+func (l *LeagueClient) IterateEntries(
+	queue queue, tier tier, division division, options ...*LeagueEntriesOptions,
+) *LeagueEntryIterator {
+	return l.IterateEntriesWithContext(context.Background(), queue, tier, division, options...)
+}
+
+// IterateEntriesWithContext is like IterateEntries but binds all requests to the given context. The iteration
+// stops with the context error once the context is done.
+func (l *LeagueClient) IterateEntriesWithContext(
+	ctx context.Context, queue queue, tier tier, division division, options ...*LeagueEntriesOptions,
+) *LeagueEntryIterator {
+	it := &LeagueEntryIterator{
+		client:   l,
+		ctx:      ctx,
+		queue:    queue,
+		tier:     tier,
+		division: division,
+		next:     1,
+	}
+	if len(options) != 0 && options[0] != nil && options[0].Page > 1 {
+		it.next = options[0].Page
+	}
+	if tier.IsApex() && it.next > 1 {
+		it.next = 0
+	}
+	return it
+}
+
+// Next advances the iterator to the next entry, requesting the next page if necessary. It returns false once all
+// entries have been returned or an error occurred.
+func (it *LeagueEntryIterator) Next() bool {
+	for {
+		if it.err != nil || it.done {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		if it.pos < len(it.page) {
+			it.current = it.page[it.pos]
+			it.pos++
+			return true
+		}
+		if it.next == 0 {
+			it.done = true
+			return false
+		}
+		it.fetch()
+	}
+}
+
+// Entry returns the current entry
+func (it *LeagueEntryIterator) Entry() *LeagueItem {
+	return it.current
+}
+
+// Page returns the page of the current entry. The entries of apex tiers are all on the first page.
+func (it *LeagueEntryIterator) Page() int {
+	return it.number
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *LeagueEntryIterator) Err() error {
+	return it.err
+}
+
+func (it *LeagueEntryIterator) fetch() {
+	it.pos = 0
+	it.number = it.next
+	if it.tier.IsApex() {
+		it.page, it.err = it.apexEntries()
+		it.next = 0
+		return
+	}
+	it.page, it.err = it.client.ListPlayersWithContext(
+		it.ctx, it.queue, it.tier, it.division, &LeagueEntriesOptions{Page: it.next},
+	)
+	if len(it.page) == 0 {
+		it.next = 0
+	} else {
+		it.next++
+	}
+}
+
+// apexEntries returns the entries of the league of an apex tier
+func (it *LeagueEntryIterator) apexEntries() ([]*LeagueItem, error) {
+	var list *LeagueList
+	var err error
+	switch it.tier {
+	case TierMaster:
+		list, err = it.client.GetMasterWithContext(it.ctx, it.queue)
+	case TierGrandMaster:
+		list, err = it.client.GetGrandmasterWithContext(it.ctx, it.queue)
+	default:
+		list, err = it.client.GetChallengerWithContext(it.ctx, it.queue)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return list.Items(), nil
+}
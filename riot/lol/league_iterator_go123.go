@@ -0,0 +1,19 @@
+//go:build go1.23
+
+package lol
+
+import "iter"
+
+// All returns the remaining entries as a sequence. An error stopping the iteration is yielded last.
+func (it *LeagueEntryIterator) All() iter.Seq2[*LeagueItem, error] {
+	return func(yield func(*LeagueItem, error) bool) {
+		for it.Next() {
+			if !yield(it.Entry(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
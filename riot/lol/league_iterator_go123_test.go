@@ -0,0 +1,30 @@
+//go:build go1.23
+
+package lol
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
+
+func TestLeagueEntryIterator_All(t *testing.T) {
+	t.Parallel()
+	var requests int
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", leagueDoer(3, &requests), logrus.StandardLogger())
+	var got []string
+	for entry, err := range (&LeagueClient{c: client}).IterateEntries(QueueRankedSolo, TierGold, DivisionOne).All() {
+		require.NoError(t, err)
+		got = append(got, entry.PUUID)
+		if len(got) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []string{"1-1", "1-2", "2-1"}, got)
+	assert.Equal(t, 2, requests)
+}
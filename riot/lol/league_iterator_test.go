@@ -0,0 +1,157 @@
+package lol
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// leagueDoer serves pages with two entries each for the given number of pages and a Challenger league
+func leagueDoer(pages int, requests *int) internal.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			*requests++
+			if r.URL.Path == "/lol/league/v4/challengerleagues/by-queue/RANKED_SOLO_5x5" {
+				return mock.NewJSONMockDoer(LeagueList{
+					LeagueID: "league",
+					Tier:     "CHALLENGER",
+					Queue:    "RANKED_SOLO_5x5",
+					Entries:  []*LeagueItem{{PUUID: "b", LeaguePoints: 900}, {PUUID: "a", LeaguePoints: 1200}},
+				}, 200).Do(r)
+			}
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			entries := []*LeagueItem{}
+			if page <= pages {
+				entries = append(entries, &LeagueItem{PUUID: fmt.Sprintf("%d-1", page)},
+					&LeagueItem{PUUID: fmt.Sprintf("%d-2", page)})
+			}
+			return mock.NewJSONMockDoer(entries, 200).Do(r)
+		},
+	}
+}
+
+func TestLeagueClient_IterateEntries(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		tier         tier
+		pages        int
+		want         []*LeagueItem
+		wantRequests int
+	}{
+		{
+			name:  "pages",
+			tier:  TierGold,
+			pages: 2,
+			want: []*LeagueItem{
+				{PUUID: "1-1"}, {PUUID: "1-2"}, {PUUID: "2-1"}, {PUUID: "2-2"},
+			},
+			wantRequests: 3,
+		},
+		{
+			name:         "empty",
+			tier:         TierIron,
+			wantRequests: 1,
+		},
+		{
+			name: "apex tier",
+			tier: TierChallenger,
+			want: []*LeagueItem{
+				{LeagueID: "league", PUUID: "a", QueueType: "RANKED_SOLO_5x5", Tier: "CHALLENGER", LeaguePoints: 1200},
+				{LeagueID: "league", PUUID: "b", QueueType: "RANKED_SOLO_5x5", Tier: "CHALLENGER", LeaguePoints: 900},
+			},
+			wantRequests: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			client := internal.NewClient(
+				api.RegionEuropeWest, "API_KEY", leagueDoer(tt.pages, &requests), logrus.StandardLogger(),
+			)
+			it := (&LeagueClient{c: client}).IterateEntries(QueueRankedSolo, tt.tier, DivisionOne)
+			var got []*LeagueItem
+			for it.Next() {
+				got = append(got, it.Entry())
+			}
+			require.NoError(t, it.Err())
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantRequests, requests)
+			assert.False(t, it.Next())
+		})
+	}
+}
+
+func TestLeagueClient_IterateEntriesPage(t *testing.T) {
+	t.Parallel()
+	var requests int
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", leagueDoer(3, &requests), logrus.StandardLogger())
+	it := (&LeagueClient{c: client}).IterateEntries(
+		QueueRankedSolo, TierGold, DivisionOne, &LeagueEntriesOptions{Page: 2},
+	)
+	var got []string
+	var pages []int
+	for it.Next() {
+		got = append(got, it.Entry().PUUID)
+		pages = append(pages, it.Page())
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"2-1", "2-2", "3-1", "3-2"}, got)
+	assert.Equal(t, []int{2, 2, 3, 3}, pages)
+	assert.Equal(t, 3, requests)
+}
+
+func TestLeagueClient_IterateEntriesApexPage(t *testing.T) {
+	t.Parallel()
+	var requests int
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", leagueDoer(3, &requests), logrus.StandardLogger())
+	it := (&LeagueClient{c: client}).IterateEntries(
+		QueueRankedSolo, TierChallenger, DivisionOne, &LeagueEntriesOptions{Page: 2},
+	)
+	assert.False(t, it.Next())
+	require.NoError(t, it.Err())
+	assert.Nil(t, it.Entry())
+	assert.Equal(t, 0, requests)
+}
+
+func TestLeagueClient_IterateEntriesError(t *testing.T) {
+	t.Parallel()
+	client := internal.NewClient(
+		api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(http.StatusForbidden), logrus.StandardLogger(),
+	)
+	for _, tier := range []tier{TierGold, TierMaster} {
+		it := (&LeagueClient{c: client}).IterateEntries(QueueRankedSolo, tier, DivisionOne)
+		assert.False(t, it.Next())
+		require.ErrorIs(t, it.Err(), api.ErrForbidden)
+	}
+}
+
+func TestLeagueClient_IterateEntriesWithContext(t *testing.T) {
+	t.Parallel()
+	var requests int
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", leagueDoer(5, &requests), logrus.StandardLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	it := (&LeagueClient{c: client}).IterateEntriesWithContext(ctx, QueueRankedSolo, TierGold, DivisionOne)
+	require.True(t, it.Next())
+	cancel()
+	assert.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), context.Canceled)
+	assert.Equal(t, 1, requests)
+}
+
+func TestTier_IsApex(t *testing.T) {
+	t.Parallel()
+	for _, tier := range Tiers {
+		assert.Equal(t, tier == TierMaster || tier == TierGrandMaster || tier == TierChallenger, tier.IsApex())
+	}
+}
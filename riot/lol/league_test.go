@@ -38,7 +38,7 @@ func TestLeagueClient_GetChallenger(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&LeagueClient{c: client}).GetChallenger(QueueRankedSolo)
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -71,7 +71,7 @@ func TestLeagueClient_GetGrandmaster(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&LeagueClient{c: client}).GetGrandmaster(QueueRankedSolo)
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -104,7 +104,7 @@ func TestLeagueClient_GetMaster(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&LeagueClient{c: client}).GetMaster(QueueRankedSolo)
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -137,7 +137,7 @@ func TestLeagueClient_ListPlayers(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&LeagueClient{c: client}).ListPlayers(QueueRankedSolo, TierGold, DivisionOne)
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -170,7 +170,40 @@ func TestLeagueClient_ListBySummoner(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&LeagueClient{c: client}).ListBySummoner("id")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestLeagueClient_ListByPUUID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    []*LeagueItem
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: []*LeagueItem{},
+			doer: mock.NewJSONMockDoer([]*LeagueItem{}, 200),
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&LeagueClient{c: client}).ListByPUUID("id")
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -203,7 +236,7 @@ func TestLeagueClient_Get(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&LeagueClient{c: client}).Get("id")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -211,3 +244,32 @@ func TestLeagueClient_Get(t *testing.T) {
 		)
 	}
 }
+
+func TestLeagueClient_ListPlayersPage(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		options []*LeagueEntriesOptions
+		want    string
+	}{
+		{name: "no options"},
+		{name: "nil options", options: []*LeagueEntriesOptions{nil}},
+		{name: "page", options: []*LeagueEntriesOptions{{Page: 3}}, want: "3"},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				doer := &mock.Doer{
+					Custom: func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, "/lol/league/v4/entries/RANKED_SOLO_5x5/GOLD/I", r.URL.Path)
+						assert.Equal(t, tt.want, r.URL.Query().Get("page"))
+						return mock.NewJSONMockDoer([]*LeagueItem{}, 200).Do(r)
+					},
+				}
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+				_, err := (&LeagueClient{c: client}).ListPlayers(QueueRankedSolo, TierGold, DivisionOne, tt.options...)
+				require.NoError(t, err)
+			},
+		)
+	}
+}
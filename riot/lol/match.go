@@ -1,13 +1,14 @@
 package lol
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"time"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // MatchClient provides methods for the match endpoints of the League of Legends API.
@@ -31,6 +32,16 @@ type MatchListOptions struct {
 	StartTime, EndTime time.Time
 }
 
+// clone returns a deep copy of the options, so changes by the caller do not affect requests made later on
+func (mo *MatchListOptions) clone() *MatchListOptions {
+	clone := *mo
+	if mo.Queue != nil {
+		queue := *mo.Queue
+		clone.Queue = &queue
+	}
+	return &clone
+}
+
 func (mo *MatchListOptions) buildParam() string {
 	var param string
 	if mo.Queue != nil {
@@ -50,11 +61,17 @@ func (mo *MatchListOptions) buildParam() string {
 
 // Get returns a match specified by its ID
 func (m *MatchClient) Get(id string) (*Match, error) {
+	return m.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but binds the request to the given context
+func (m *MatchClient) GetWithContext(ctx context.Context, id string) (*Match, error) {
 	logger := m.logger().WithField("method", "Get")
-	c := *m.c                                          // copy client
-	c.Region = api.Region(api.RegionToRoute[c.Region]) // Match v5 uses a route instead of a region
+	c := *m.c                               // copy client
+	c.Region = api.Region(c.Region.Route()) // Match v5 uses a route instead of a region
 	var match *Match
-	if err := c.GetInto(fmt.Sprintf(endpointGetMatch, id), &match); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetMatch)
+	if err := c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetMatch, id), &match); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -65,15 +82,23 @@ func (m *MatchClient) Get(id string) (*Match, error) {
 func (m *MatchClient) List(puuid string, start, count int, options ...*MatchListOptions) (
 	[]string, error,
 ) {
+	return m.ListWithContext(context.Background(), puuid, start, count, options...)
+}
+
+// ListWithContext is like List but binds the request to the given context
+func (m *MatchClient) ListWithContext(
+	ctx context.Context, puuid string, start, count int, options ...*MatchListOptions,
+) ([]string, error) {
 	logger := m.logger().WithField("method", "List")
-	c := *m.c                                          // copy client
-	c.Region = api.Region(api.RegionToRoute[c.Region]) // Match v5 uses a route instead of a region
+	c := *m.c                               // copy client
+	c.Region = api.Region(c.Region.Route()) // Match v5 uses a route instead of a region
 	var matches []string
 	endpoint := fmt.Sprintf(endpointGetMatchIDs, puuid, start, count)
-	if len(options) != 0 {
+	if len(options) != 0 && options[0] != nil {
 		endpoint += options[0].buildParam()
 	}
-	if err := c.GetInto(endpoint, &matches); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetMatchIDs)
+	if err := c.GetIntoWithContext(ctx, endpoint, &matches); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -89,53 +114,195 @@ type MatchStreamValue struct {
 // ListStream returns all matches played on this account as a stream, requesting new until there are no
 // more new games
 func (m *MatchClient) ListStream(puuid string, options ...*MatchListOptions) <-chan MatchStreamValue {
+	return m.ListStreamWithContext(context.Background(), puuid, options...)
+}
+
+// ListStreamWithContext is like ListStream but binds all requests to the given context. The stream is
+// closed once the context is done.
+func (m *MatchClient) ListStreamWithContext(
+	ctx context.Context, puuid string, options ...*MatchListOptions,
+) <-chan MatchStreamValue {
 	logger := m.logger().WithField("method", "ListStream")
 	cMatches := make(chan MatchStreamValue, 100)
-
-	// Copy the input options to prevent caller modification while streaming
-	opts := make([]*MatchListOptions, 0)
-	for _, o := range options {
-		// Copy the value in case the caller modifies it after we return
-		queue := *options[0].Queue
-		// Shallow copy the other values
-		newOpt := *o
-		newOpt.Queue = &queue
-		opts = append(opts, &newOpt)
-	}
-	if len(options) != 0 && options[0].Queue != nil {
-		// Copy the value in case the caller modifies it after we return
-		queue := *options[0].Queue
-		options[0].Queue = &queue
+	var opts MatchIteratorOptions
+	if len(options) != 0 && options[0] != nil {
+		// Copy the options in case the caller modifies them while streaming
+		opts.MatchListOptions = *options[0].clone()
 	}
+	it := m.IterateWithContext(ctx, puuid, &opts)
 	go func() {
 		defer close(cMatches)
-		start := 0
-		for {
-			matches, err := m.List(puuid, start, 100, opts...)
-			if err != nil {
-				logger.Debug(err)
-				cMatches <- MatchStreamValue{Error: err}
+		for it.Next() {
+			select {
+			case cMatches <- MatchStreamValue{MatchID: it.Match()}:
+			case <-ctx.Done():
 				return
 			}
-			for _, match := range matches {
-				cMatches <- MatchStreamValue{MatchID: match}
+		}
+		if err := it.Err(); err != nil {
+			logger.Debug(err)
+			select {
+			case cMatches <- MatchStreamValue{Error: err}:
+			case <-ctx.Done():
 			}
-			if len(matches) < 100 {
+		}
+	}()
+	return cMatches
+}
+
+// DefaultMatchFetchWorkers is the number of matches fetched concurrently if MatchFetchOptions.Workers is not set
+const DefaultMatchFetchWorkers = 4
+
+// MatchFetchOptions providing additional options for GetMany and FetchStream
+type MatchFetchOptions struct {
+	// Workers is the number of matches fetched concurrently. DefaultMatchFetchWorkers is used if it is not set.
+	// All requests pass the rate limiter of the client, so more workers only help while there is rate limit left.
+	Workers int
+	// Timelines fetches the timeline of every match as well
+	Timelines bool
+}
+
+// MatchFetchResult value returned by GetMany and FetchStream, containing either a match or an error
+type MatchFetchResult struct {
+	// Index is the position of the match ID in the input
+	Index    int
+	MatchID  string
+	Match    *Match
+	Timeline *MatchTimeline
+	// Error is set if the match or its timeline could not be fetched. Match is kept if only the timeline failed.
+	Error error
+}
+
+// GetMany returns the matches with the given IDs, fetching them concurrently. The results are in the order of
+// the IDs and failures are reported per match.
+func (m *MatchClient) GetMany(ids []string, options *MatchFetchOptions) []MatchFetchResult {
+	return m.GetManyWithContext(context.Background(), ids, options)
+}
+
+// GetManyWithContext is like GetMany but binds all requests to the given context. Matches which were not
+// fetched before the context is done have the context error set.
+func (m *MatchClient) GetManyWithContext(
+	ctx context.Context, ids []string, options *MatchFetchOptions,
+) []MatchFetchResult {
+	cIDs := make(chan MatchStreamValue)
+	go func() {
+		defer close(cIDs)
+		for _, id := range ids {
+			select {
+			case cIDs <- MatchStreamValue{MatchID: id}:
+			case <-ctx.Done():
 				return
 			}
-			start += 100
 		}
 	}()
-	return cMatches
+	results := make([]MatchFetchResult, len(ids))
+	fetched := make([]bool, len(ids))
+	for result := range m.FetchStreamWithContext(ctx, cIDs, options) {
+		results[result.Index] = result
+		fetched[result.Index] = true
+	}
+	for i, ok := range fetched {
+		if !ok {
+			results[i] = MatchFetchResult{Index: i, MatchID: ids[i], Error: ctx.Err()}
+		}
+	}
+	return results
+}
+
+// FetchStream fetches the matches with the IDs received from the given stream concurrently, e.g. the stream
+// returned by ListStream. Results are sent as soon as they are fetched, so they may be out of order.
+// Errors received from the stream are passed on as results. The returned stream is closed once the given
+// stream is closed and all matches are fetched.
+func (m *MatchClient) FetchStream(ids <-chan MatchStreamValue, options *MatchFetchOptions) <-chan MatchFetchResult {
+	return m.FetchStreamWithContext(context.Background(), ids, options)
+}
+
+// FetchStreamWithContext is like FetchStream but binds all requests to the given context. The stream is
+// closed once the context is done.
+func (m *MatchClient) FetchStreamWithContext(
+	ctx context.Context, ids <-chan MatchStreamValue, options *MatchFetchOptions,
+) <-chan MatchFetchResult {
+	var opts MatchFetchOptions
+	if options != nil {
+		opts = *options
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = DefaultMatchFetchWorkers
+	}
+	type job struct {
+		index int
+		value MatchStreamValue
+	}
+	jobs := make(chan job)
+	cResults := make(chan MatchFetchResult, opts.Workers)
+	go func() {
+		defer close(jobs)
+		for index := 0; ; index++ {
+			var value MatchStreamValue
+			var ok bool
+			select {
+			case value, ok = <-ids:
+				if !ok {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- job{index: index, value: value}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	var wg sync.WaitGroup
+	for i := 0; i < opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				result := MatchFetchResult{Index: j.index, MatchID: j.value.MatchID, Error: j.value.Error}
+				if result.Error == nil {
+					m.fetch(ctx, &result, opts.Timelines)
+				}
+				select {
+				case cResults <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(cResults)
+	}()
+	return cResults
+}
+
+// fetch fetches the match of the result and optionally its timeline
+func (m *MatchClient) fetch(ctx context.Context, result *MatchFetchResult, timeline bool) {
+	result.Match, result.Error = m.GetWithContext(ctx, result.MatchID)
+	if result.Error != nil || !timeline {
+		return
+	}
+	result.Timeline, result.Error = m.GetTimelineWithContext(ctx, result.MatchID)
 }
 
 // GetTimeline returns the timeline for the given match
 // NOTE: timelines are not available for every match
-// TODO: update to v5 when struct is documented
 func (m *MatchClient) GetTimeline(id string) (*MatchTimeline, error) {
+	return m.GetTimelineWithContext(context.Background(), id)
+}
+
+// GetTimelineWithContext is like GetTimeline but binds the request to the given context
+func (m *MatchClient) GetTimelineWithContext(ctx context.Context, id string) (*MatchTimeline, error) {
 	logger := m.logger().WithField("method", "GetTimeline")
+	c := *m.c                               // copy client
+	c.Region = api.Region(c.Region.Route()) // Match v5 uses a route instead of a region
 	var timeline MatchTimeline
-	if err := m.c.GetInto(fmt.Sprintf(endpointGetMatchTimeline, id), &timeline); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetMatchTimeline)
+	if err := c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetMatchTimeline, id), &timeline); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -0,0 +1,200 @@
+package lol
+
+import (
+	"context"
+	"time"
+)
+
+// MatchHistoryStart is the earliest time the match history can be filtered by. Matches played before this time
+// are only listed if no start time is given.
+var MatchHistoryStart = time.Date(2021, time.June, 16, 0, 0, 0, 0, time.UTC)
+
+// MatchIteratorOptions providing additional options for Iterate
+type MatchIteratorOptions struct {
+	MatchListOptions
+	// Start is the number of match IDs to skip
+	Start int
+	// MaxTotal is the maximum number of match IDs to return. All match IDs are returned if it is not set.
+	MaxTotal int
+	// PageSize is the number of match IDs requested at once. 100, the maximum allowed by the API, is used if it
+	// is not set.
+	PageSize int
+	// Window slices the match history into time windows of the given duration which are listed one after the
+	// other, newest first. This allows listing histories which are too long to be paged through in one go.
+	// The history is sliced from EndTime (or now) back to StartTime (or MatchHistoryStart).
+	Window time.Duration
+}
+
+// MatchIterator iterates over the match IDs of a player, newest first, requesting pages as they are needed
+//
+//	it := client.Match.Iterate(puuid, nil)
+//	for it.Next() {
+//		fmt.Println(it.Match())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type MatchIterator struct {
+	client *MatchClient
+	ctx    context.Context
+	puuid  string
+	opts   MatchIteratorOptions
+
+	page     []string
+	pos      int
+	start    int
+	last     bool
+	skip     int
+	returned int
+	current  string
+	err      error
+	done     bool
+
+	windowed    bool
+	lowerBound  time.Time
+	windowStart time.Time
+	windowEnd   time.Time
+	// seen holds the IDs of the current and previous window, so matches on the border are not returned twice
+	seen, previous map[string]struct{}
+}
+
+// Iterate returns an iterator over the match IDs of the player with the given PUUID
+func (m *MatchClient) Iterate(puuid string, options *MatchIteratorOptions) *MatchIterator {
+	return m.IterateWithContext(context.Background(), puuid, options)
+}
+
+// IterateWithContext is like Iterate but binds all requests to the given context. The iteration stops with the
+// context error once the context is done.
+func (m *MatchClient) IterateWithContext(
+	ctx context.Context, puuid string, options *MatchIteratorOptions,
+) *MatchIterator {
+	it := &MatchIterator{
+		client: m,
+		ctx:    ctx,
+		puuid:  puuid,
+	}
+	if options != nil {
+		it.opts = *options
+		it.opts.MatchListOptions = *options.MatchListOptions.clone()
+	}
+	if it.opts.PageSize <= 0 || it.opts.PageSize > 100 {
+		it.opts.PageSize = 100
+	}
+	if it.opts.Window <= 0 {
+		it.start = it.opts.Start
+		return it
+	}
+	it.windowed = true
+	it.skip = it.opts.Start
+	it.lowerBound = it.opts.StartTime
+	if it.lowerBound.IsZero() {
+		it.lowerBound = MatchHistoryStart
+	}
+	it.windowEnd = it.opts.EndTime
+	if it.windowEnd.IsZero() {
+		it.windowEnd = time.Now()
+	}
+	it.windowStart = maxTime(it.windowEnd.Add(-it.opts.Window), it.lowerBound)
+	it.seen = map[string]struct{}{}
+	return it
+}
+
+// Next advances the iterator to the next match ID, requesting the next page if necessary. It returns false once
+// all match IDs have been returned or an error occurred.
+func (it *MatchIterator) Next() bool {
+	for {
+		if it.err != nil || it.done {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		if it.opts.MaxTotal > 0 && it.returned >= it.opts.MaxTotal {
+			it.done = true
+			return false
+		}
+		if it.pos < len(it.page) {
+			id := it.page[it.pos]
+			it.pos++
+			if !it.fresh(id) {
+				continue
+			}
+			if it.skip > 0 {
+				it.skip--
+				continue
+			}
+			it.current = id
+			it.returned++
+			return true
+		}
+		if it.last && !it.nextWindow() {
+			it.done = true
+			return false
+		}
+		it.fetch()
+	}
+}
+
+// Match returns the current match ID
+func (it *MatchIterator) Match() string {
+	return it.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *MatchIterator) Err() error {
+	return it.err
+}
+
+func (it *MatchIterator) fetch() {
+	count := it.opts.PageSize
+	if it.opts.MaxTotal > 0 {
+		if remaining := it.opts.MaxTotal - it.returned + it.skip; remaining < count {
+			count = remaining
+		}
+	}
+	options := it.opts.MatchListOptions
+	if it.windowed {
+		options.StartTime = it.windowStart
+		options.EndTime = it.windowEnd
+	}
+	it.page, it.err = it.client.ListWithContext(it.ctx, it.puuid, it.start, count, &options)
+	it.pos = 0
+	it.start += len(it.page)
+	it.last = len(it.page) < count
+}
+
+// fresh reports whether the ID was not yet returned in the current or previous window
+func (it *MatchIterator) fresh(id string) bool {
+	if !it.windowed {
+		return true
+	}
+	if _, ok := it.previous[id]; ok {
+		return false
+	}
+	if _, ok := it.seen[id]; ok {
+		return false
+	}
+	it.seen[id] = struct{}{}
+	return true
+}
+
+// nextWindow moves to the preceding time window. It returns false if there is none.
+func (it *MatchIterator) nextWindow() bool {
+	if !it.windowed || !it.windowStart.After(it.lowerBound) {
+		return false
+	}
+	it.windowEnd = it.windowStart
+	it.windowStart = maxTime(it.windowEnd.Add(-it.opts.Window), it.lowerBound)
+	it.previous, it.seen = it.seen, map[string]struct{}{}
+	it.start = 0
+	it.last = false
+	return true
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
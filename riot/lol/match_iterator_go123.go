@@ -0,0 +1,23 @@
+//go:build go1.23
+
+package lol
+
+import "iter"
+
+// All returns the remaining match IDs as a sequence. An error stopping the iteration is yielded last.
+//
+//	for id, err := range client.Match.Iterate(puuid, nil).All() {
+//		...
+//	}
+func (it *MatchIterator) All() iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for it.Next() {
+			if !yield(it.Match(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			yield("", err)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+//go:build go1.23
+
+package lol
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestMatchIterator_All(t *testing.T) {
+	t.Parallel()
+	var requests int32
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", historyDoer(150, &requests), logrus.StandardLogger())
+	var got []string
+	for id, err := range (&MatchClient{c: client}).Iterate("puuid", nil).All() {
+		require.NoError(t, err)
+		got = append(got, id)
+		if len(got) == 120 {
+			break
+		}
+	}
+	assert.Equal(t, matchIDs(0, 120), got)
+	assert.Equal(t, int32(2), requests)
+
+	client = internal.NewClient(
+		api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(http.StatusNotFound), logrus.StandardLogger(),
+	)
+	var errs []error
+	for id, err := range (&MatchClient{c: client}).Iterate("puuid", nil).All() {
+		assert.Empty(t, id)
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], api.ErrNotFound)
+}
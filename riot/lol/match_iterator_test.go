@@ -0,0 +1,194 @@
+package lol
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+var historyEnd = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// historyDoer serves a history of n matches played one hour apart, the newest at historyEnd. The time filters
+// are inclusive on both ends.
+func historyDoer(n int, requests *int32) internal.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(requests, 1)
+			query := r.URL.Query()
+			start, _ := strconv.Atoi(query.Get("start"))
+			count, _ := strconv.Atoi(query.Get("count"))
+			ids := make([]string, 0)
+			for i := 0; i < n; i++ {
+				played := historyEnd.Add(-time.Duration(i) * time.Hour).Unix()
+				if s := query.Get("startTime"); s != "" && played < parseInt64(s) {
+					continue
+				}
+				if e := query.Get("endTime"); e != "" && played > parseInt64(e) {
+					continue
+				}
+				ids = append(ids, fmt.Sprintf("EUW1_%d", i))
+			}
+			if start > len(ids) {
+				start = len(ids)
+			}
+			if start+count < len(ids) {
+				ids = ids[:start+count]
+			}
+			return mock.NewJSONMockDoer(ids[start:], 200).Do(r)
+		},
+	}
+}
+
+func parseInt64(s string) int64 {
+	v, _ := strconv.ParseInt(s, 10, 64)
+	return v
+}
+
+func matchIDs(from, to int) []string {
+	ids := make([]string, 0, to-from)
+	for i := from; i < to; i++ {
+		ids = append(ids, fmt.Sprintf("EUW1_%d", i))
+	}
+	return ids
+}
+
+func TestMatchClient_Iterate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		history      int
+		options      *MatchIteratorOptions
+		want         []string
+		wantRequests int32
+	}{
+		{
+			name:         "no options",
+			history:      250,
+			want:         matchIDs(0, 250),
+			wantRequests: 3,
+		},
+		{
+			name:         "exact page",
+			history:      100,
+			want:         matchIDs(0, 100),
+			wantRequests: 2,
+		},
+		{
+			name:         "start and max total",
+			history:      250,
+			options:      &MatchIteratorOptions{Start: 20, MaxTotal: 30},
+			want:         matchIDs(20, 50),
+			wantRequests: 1,
+		},
+		{
+			name:         "page size",
+			history:      25,
+			options:      &MatchIteratorOptions{PageSize: 10},
+			want:         matchIDs(0, 25),
+			wantRequests: 3,
+		},
+		{
+			name:    "windows",
+			history: 50,
+			options: &MatchIteratorOptions{
+				MatchListOptions: MatchListOptions{
+					StartTime: historyEnd.Add(-49 * time.Hour),
+					EndTime:   historyEnd,
+				},
+				PageSize: 5,
+				Window:   10 * time.Hour,
+			},
+			want: matchIDs(0, 50),
+			// 5 windows with 11 matches each (borders are inclusive), 3 requests per window
+			wantRequests: 15,
+		},
+		{
+			name:    "windows with start and max total",
+			history: 50,
+			options: &MatchIteratorOptions{
+				MatchListOptions: MatchListOptions{
+					StartTime: historyEnd.Add(-49 * time.Hour),
+					EndTime:   historyEnd,
+				},
+				Start:    8,
+				MaxTotal: 5,
+				Window:   10 * time.Hour,
+			},
+			want: matchIDs(8, 13),
+			// the duplicate on the window border costs an additional request
+			wantRequests: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			client := internal.NewClient(
+				api.RegionEuropeWest, "API_KEY", historyDoer(tt.history, &requests), logrus.StandardLogger(),
+			)
+			it := (&MatchClient{c: client}).Iterate("puuid", tt.options)
+			var got []string
+			for it.Next() {
+				got = append(got, it.Match())
+			}
+			require.NoError(t, it.Err())
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantRequests, requests)
+			assert.False(t, it.Next())
+		})
+	}
+}
+
+func TestMatchClient_IterateError(t *testing.T) {
+	t.Parallel()
+	client := internal.NewClient(
+		api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(http.StatusNotFound), logrus.StandardLogger(),
+	)
+	it := (&MatchClient{c: client}).Iterate("puuid", nil)
+	assert.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), api.ErrNotFound)
+	assert.False(t, it.Next())
+}
+
+func TestMatchClient_IterateWithContext(t *testing.T) {
+	t.Parallel()
+	var requests int32
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", historyDoer(250, &requests), logrus.StandardLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	it := (&MatchClient{c: client}).IterateWithContext(ctx, "puuid", nil)
+	require.True(t, it.Next())
+	cancel()
+	assert.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), context.Canceled)
+	assert.Equal(t, int32(1), requests)
+}
+
+func TestMatchClient_IterateOptionsCopied(t *testing.T) {
+	t.Parallel()
+	var requests int32
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&requests, 1)
+			assert.Equal(t, "420", r.URL.Query().Get("queue"))
+			return mock.NewJSONMockDoer([]string{}, 200).Do(r)
+		},
+	}, logrus.StandardLogger())
+	queue := 420
+	options := &MatchIteratorOptions{MatchListOptions: MatchListOptions{Queue: &queue}}
+	it := (&MatchClient{c: client}).Iterate("puuid", options)
+	queue = 440
+	assert.False(t, it.Next())
+	require.NoError(t, it.Err())
+	assert.Equal(t, int32(1), requests)
+}
@@ -1,9 +1,11 @@
 package lol
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -48,7 +50,7 @@ func TestMatchClient_List(t *testing.T) {
 						EndTime:   time.Now().Add(time.Hour),
 					},
 				)
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -98,7 +100,7 @@ func TestMatchClient_ListStream(t *testing.T) {
 				)
 				for res := range got {
 					if res.Error != nil && tt.wantErr != nil {
-						require.Equal(t, res.Error, tt.wantErr)
+						require.ErrorIs(t, res.Error, tt.wantErr)
 						break
 					} else if res.Error != nil {
 						require.Equal(t, res.Error, io.EOF)
@@ -110,6 +112,51 @@ func TestMatchClient_ListStream(t *testing.T) {
 	}
 }
 
+func TestMatchClient_ListStreamOptions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		options []*MatchListOptions
+	}{
+		{name: "no options"},
+		{name: "nil options", options: []*MatchListOptions{nil}},
+		{name: "no queue", options: []*MatchListOptions{{Type: "ranked"}}},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(
+					api.RegionEuropeWest, "API_KEY", mock.NewJSONMockDoer(make([]string, 10), 200),
+					logrus.StandardLogger(),
+				)
+				var n int
+				for res := range (&MatchClient{c: client}).ListStream("id", tt.options...) {
+					require.NoError(t, res.Error)
+					n++
+				}
+				assert.Equal(t, 10, n)
+			},
+		)
+	}
+}
+
+func TestMatchClient_ListStreamWithContext(t *testing.T) {
+	t.Parallel()
+	client := internal.NewClient(
+		api.RegionEuropeWest, "API_KEY", mock.NewJSONMockDoer(make([]string, 100), 200), logrus.StandardLogger(),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	got := (&MatchClient{c: client}).ListStreamWithContext(ctx, "id")
+	for i := 0; i < 150; i++ {
+		res := <-got
+		require.Nil(t, res.Error)
+	}
+	cancel()
+	// the stream keeps paging forever unless it stops once the context is done
+	for range got {
+	}
+}
+
 func TestMatchClient_Get(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -134,7 +181,7 @@ func TestMatchClient_Get(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&MatchClient{c: client}).Get("NA_1")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -167,7 +214,7 @@ func TestMatchClient_GetTimeline(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&MatchClient{c: client}).GetTimeline("0")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -175,3 +222,94 @@ func TestMatchClient_GetTimeline(t *testing.T) {
 		)
 	}
 }
+
+// matchDoer responds with a match or timeline for the requested ID. The match "missing" and the timeline of
+// "no-timeline" are not found.
+func matchDoer() internal.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			id := strings.TrimPrefix(r.URL.Path, "/lol/match/v5/matches/")
+			if id, ok := strings.CutSuffix(id, "/timeline"); ok {
+				if id == "no-timeline" {
+					return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+				}
+				return mock.NewJSONMockDoer(MatchTimeline{Metadata: &MatchMetadata{MatchID: id}}, 200).Do(r)
+			}
+			if id == "missing" {
+				return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+			}
+			return mock.NewJSONMockDoer(Match{Metadata: &MatchMetadata{MatchID: id}}, 200).Do(r)
+		},
+	}
+}
+
+func TestMatchClient_GetMany(t *testing.T) {
+	t.Parallel()
+	ids := []string{"EUW1_1", "missing", "EUW1_3", "no-timeline", "EUW1_5", "EUW1_6"}
+	tests := []struct {
+		name    string
+		options *MatchFetchOptions
+		wantErr []error
+	}{
+		{
+			name:    "default options",
+			wantErr: []error{nil, api.ErrNotFound, nil, nil, nil, nil},
+		},
+		{
+			name:    "timelines",
+			options: &MatchFetchOptions{Workers: 3, Timelines: true},
+			wantErr: []error{nil, api.ErrNotFound, nil, api.ErrNotFound, nil, nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", matchDoer(), logrus.StandardLogger())
+				got := (&MatchClient{c: client}).GetMany(ids, tt.options)
+				require.Len(t, got, len(ids))
+				for i, result := range got {
+					assert.Equal(t, i, result.Index)
+					assert.Equal(t, ids[i], result.MatchID)
+					assert.ErrorIs(t, result.Error, tt.wantErr[i])
+					if result.Match != nil {
+						assert.Equal(t, ids[i], result.Match.Metadata.MatchID)
+					}
+					if tt.options != nil && tt.options.Timelines && tt.wantErr[i] == nil {
+						assert.Equal(t, ids[i], result.Timeline.Metadata.MatchID)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestMatchClient_GetManyWithContext(t *testing.T) {
+	t.Parallel()
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", matchDoer(), logrus.StandardLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got := (&MatchClient{c: client}).GetManyWithContext(ctx, []string{"EUW1_1", "EUW1_2"}, nil)
+	require.Len(t, got, 2)
+	for _, result := range got {
+		assert.ErrorIs(t, result.Error, context.Canceled)
+	}
+}
+
+func TestMatchClient_FetchStream(t *testing.T) {
+	t.Parallel()
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", matchDoer(), logrus.StandardLogger())
+	ids := make(chan MatchStreamValue, 3)
+	ids <- MatchStreamValue{MatchID: "EUW1_1"}
+	ids <- MatchStreamValue{MatchID: "EUW1_2"}
+	ids <- MatchStreamValue{Error: api.ErrRateLimitExceeded}
+	close(ids)
+	got := map[int]MatchFetchResult{}
+	for result := range (&MatchClient{c: client}).FetchStream(ids, &MatchFetchOptions{Workers: 2}) {
+		got[result.Index] = result
+	}
+	require.Len(t, got, 3)
+	assert.Equal(t, "EUW1_1", got[0].Match.Metadata.MatchID)
+	assert.Equal(t, "EUW1_2", got[1].Match.Metadata.MatchID)
+	assert.ErrorIs(t, got[2].Error, api.ErrRateLimitExceeded)
+	assert.Nil(t, got[2].Match)
+}
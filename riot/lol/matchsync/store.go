@@ -0,0 +1,84 @@
+package matchsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// MemoryStore is a Store keeping cursors in memory
+type MemoryStore struct {
+	mu      sync.Mutex
+	cursors map[string]Cursor
+}
+
+// NewMemoryStore returns a new empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{cursors: map[string]Cursor{}}
+}
+
+// Load implements the Store interface
+func (s *MemoryStore) Load(_ context.Context, puuid string) (*Cursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cursor, ok := s.cursors[puuid]
+	if !ok {
+		return nil, nil
+	}
+	return &cursor, nil
+}
+
+// Save implements the Store interface
+func (s *MemoryStore) Save(_ context.Context, puuid string, cursor *Cursor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[puuid] = *cursor
+	return nil
+}
+
+// FileStore is a Store keeping the cursor of every player as a JSON file in a directory
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a new store keeping its cursors in the given directory, creating it if necessary
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Load implements the Store interface
+func (s *FileStore) Load(_ context.Context, puuid string) (*Cursor, error) {
+	content, err := os.ReadFile(s.path(puuid))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cursor *Cursor
+	if err := json.Unmarshal(content, &cursor); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// Save implements the Store interface. The cursor is replaced atomically.
+func (s *FileStore) Save(_ context.Context, puuid string, cursor *Cursor) error {
+	content, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	return internal.WriteFileAtomic(s.path(puuid), content)
+}
+
+func (s *FileStore) path(puuid string) string {
+	return filepath.Join(s.dir, filepath.Base(puuid)+".json")
+}
@@ -0,0 +1,55 @@
+package matchsync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+	fileStore, err := NewFileStore(filepath.Join(t.TempDir(), "cursors"))
+	require.NoError(t, err)
+	tests := []struct {
+		name  string
+		store Store
+	}{
+		{name: "memory", store: NewMemoryStore()},
+		{name: "file", store: fileStore},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cursor, err := tt.store.Load(ctx, "puuid")
+			require.NoError(t, err)
+			assert.Nil(t, cursor)
+			want := &Cursor{
+				MatchID:      "EUW1_1",
+				GameCreation: time.UnixMilli(1700000000000).UTC(),
+				UpdatedAt:    time.UnixMilli(1700000100000).UTC(),
+			}
+			require.NoError(t, tt.store.Save(ctx, "puuid", want))
+			cursor, err = tt.store.Load(ctx, "puuid")
+			require.NoError(t, err)
+			assert.Equal(t, want, cursor)
+			cursor, err = tt.store.Load(ctx, "other")
+			require.NoError(t, err)
+			assert.Nil(t, cursor)
+		})
+	}
+}
+
+func TestFileStore_Load(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "puuid.json"), []byte("{"), 0o600))
+	_, err = store.Load(context.Background(), "puuid")
+	require.Error(t, err)
+}
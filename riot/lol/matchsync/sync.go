@@ -0,0 +1,126 @@
+// Package matchsync keeps the match histories of players up to date by only requesting the matches played
+// since the last synchronization. The progress for every player is kept in a Store as a Cursor.
+package matchsync
+
+import (
+	"context"
+	"time"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// Cursor marks the newest match of a player which was handled successfully
+type Cursor struct {
+	MatchID string `json:"matchId"`
+	// GameCreation is the time the match was created on the game server
+	GameCreation time.Time `json:"gameCreation"`
+	// UpdatedAt is the time the cursor was saved
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Store persists the cursors of players
+type Store interface {
+	// Load returns the cursor for the player with the given PUUID or nil if the player was never synchronized
+	Load(ctx context.Context, puuid string) (*Cursor, error)
+	// Save replaces the cursor for the player with the given PUUID
+	Save(ctx context.Context, puuid string, cursor *Cursor) error
+}
+
+// Handler is called for every new match. Returning an error stops the synchronization without advancing the
+// cursor past the match.
+type Handler func(ctx context.Context, match *lol.Match) error
+
+// Options restrict which matches are synchronized
+type Options struct {
+	// Queue only synchronizes matches of the given queue
+	Queue *int
+	// Type only synchronizes matches of the given type
+	Type string
+	// Since is the time the first synchronization of a player starts at. The whole history is synchronized if
+	// it is not set.
+	Since time.Time
+	// Window is passed on to lol.MatchIteratorOptions for long histories
+	Window time.Duration
+}
+
+// Syncer synchronizes match histories. Synchronizing the same player concurrently is not supported.
+type Syncer struct {
+	client  *lol.MatchClient
+	store   Store
+	options Options
+	now     func() time.Time
+}
+
+// New returns a Syncer requesting matches with the client and keeping cursors in the store
+func New(client *lol.MatchClient, store Store, options *Options) *Syncer {
+	s := &Syncer{
+		client: client,
+		store:  store,
+		now:    time.Now,
+	}
+	if options != nil {
+		s.options = *options
+	}
+	return s
+}
+
+// Sync passes every match of the player with the given PUUID played since the last synchronization to the
+// handler, oldest first. The cursor is saved after every handled match, so an interrupted synchronization
+// continues where it stopped. It returns the number of handled matches.
+func (s *Syncer) Sync(ctx context.Context, puuid string, handler Handler) (int, error) {
+	cursor, err := s.store.Load(ctx, puuid)
+	if err != nil {
+		return 0, err
+	}
+	ids, err := s.newMatchIDs(ctx, puuid, cursor)
+	if err != nil {
+		return 0, err
+	}
+	var handled int
+	for i := len(ids) - 1; i >= 0; i-- {
+		match, err := s.client.GetWithContext(ctx, ids[i])
+		if err != nil {
+			return handled, err
+		}
+		if err := handler(ctx, match); err != nil {
+			return handled, err
+		}
+		next := &Cursor{
+			MatchID:   ids[i],
+			UpdatedAt: s.now(),
+		}
+		if match.Info != nil {
+			next.GameCreation = time.UnixMilli(match.Info.GameCreation)
+		}
+		if err := s.store.Save(ctx, puuid, next); err != nil {
+			return handled, err
+		}
+		handled++
+	}
+	return handled, nil
+}
+
+// newMatchIDs returns the IDs of the matches newer than the cursor, newest first
+func (s *Syncer) newMatchIDs(ctx context.Context, puuid string, cursor *Cursor) ([]string, error) {
+	options := &lol.MatchIteratorOptions{
+		MatchListOptions: lol.MatchListOptions{
+			Queue:     s.options.Queue,
+			Type:      s.options.Type,
+			StartTime: s.options.Since,
+		},
+		Window: s.options.Window,
+	}
+	if cursor != nil && !cursor.GameCreation.IsZero() {
+		// The filter has a precision of seconds, so the cursor match itself is listed again
+		options.StartTime = cursor.GameCreation.Truncate(time.Second)
+	}
+	var ids []string
+	it := s.client.IterateWithContext(ctx, puuid, options)
+	for it.Next() {
+		if cursor != nil && it.Match() == cursor.MatchID {
+			break
+		}
+		ids = append(ids, it.Match())
+	}
+	return ids, it.Err()
+}
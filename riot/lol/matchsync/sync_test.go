@@ -0,0 +1,153 @@
+package matchsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+var historyStart = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// history serves the match history of a single player. Match i is created i hours after historyStart.
+type history struct {
+	mu     sync.Mutex
+	played int
+}
+
+func (h *history) play(n int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.played += n
+}
+
+func (h *history) Do(r *http.Request) (*http.Response, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !strings.Contains(r.URL.Path, "/by-puuid/") {
+		id := strings.TrimPrefix(r.URL.Path, "/lol/match/v5/matches/")
+		i, _ := strconv.Atoi(strings.TrimPrefix(id, "EUW1_"))
+		created := historyStart.Add(time.Duration(i) * time.Hour).UnixMilli()
+		match := lol.Match{Metadata: &lol.MatchMetadata{MatchID: id}, Info: &lol.MatchInfo{GameCreation: created}}
+		return mock.NewJSONMockDoer(match, 200).Do(r)
+	}
+	query := r.URL.Query()
+	start, _ := strconv.Atoi(query.Get("start"))
+	count, _ := strconv.Atoi(query.Get("count"))
+	ids := []string{}
+	for i := h.played - 1; i >= 0; i-- {
+		created := historyStart.Add(time.Duration(i) * time.Hour).Unix()
+		if s, err := strconv.ParseInt(query.Get("startTime"), 10, 64); err == nil && created < s {
+			continue
+		}
+		ids = append(ids, fmt.Sprintf("EUW1_%d", i))
+	}
+	if start+count < len(ids) {
+		ids = ids[:start+count]
+	}
+	if start < len(ids) {
+		ids = ids[start:]
+	} else {
+		ids = ids[:0]
+	}
+	return mock.NewJSONMockDoer(ids, 200).Do(r)
+}
+
+func newSyncer(h *history, store Store) *Syncer {
+	client := lol.NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", h, logrus.StandardLogger()))
+	return New(client.Match, store, nil)
+}
+
+func collect(ids *[]string) Handler {
+	return func(_ context.Context, match *lol.Match) error {
+		*ids = append(*ids, match.Metadata.MatchID)
+		return nil
+	}
+}
+
+func TestSyncer_Sync(t *testing.T) {
+	t.Parallel()
+	h := &history{}
+	store := NewMemoryStore()
+	s := newSyncer(h, store)
+	ctx := context.Background()
+
+	h.play(3)
+	var got []string
+	n, err := s.Sync(ctx, "puuid", collect(&got))
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []string{"EUW1_0", "EUW1_1", "EUW1_2"}, got)
+	cursor, err := store.Load(ctx, "puuid")
+	require.NoError(t, err)
+	assert.Equal(t, "EUW1_2", cursor.MatchID)
+	assert.True(t, historyStart.Add(2*time.Hour).Equal(cursor.GameCreation))
+
+	n, err = s.Sync(ctx, "puuid", collect(&got))
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	h.play(2)
+	got = nil
+	n, err = s.Sync(ctx, "puuid", collect(&got))
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"EUW1_3", "EUW1_4"}, got)
+}
+
+func TestSyncer_SyncHandlerError(t *testing.T) {
+	t.Parallel()
+	h := &history{}
+	store := NewMemoryStore()
+	s := newSyncer(h, store)
+	ctx := context.Background()
+	h.play(3)
+	errHandler := errors.New("handler")
+	n, err := s.Sync(ctx, "puuid", func(_ context.Context, match *lol.Match) error {
+		if match.Metadata.MatchID == "EUW1_1" {
+			return errHandler
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errHandler)
+	assert.Equal(t, 1, n)
+	cursor, err := store.Load(ctx, "puuid")
+	require.NoError(t, err)
+	assert.Equal(t, "EUW1_0", cursor.MatchID)
+
+	var got []string
+	n, err = s.Sync(ctx, "puuid", collect(&got))
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"EUW1_1", "EUW1_2"}, got)
+}
+
+func TestSyncer_SyncError(t *testing.T) {
+	t.Parallel()
+	client := lol.NewClient(
+		internal.NewClient(
+			api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(http.StatusNotFound), logrus.StandardLogger(),
+		),
+	)
+	store := NewMemoryStore()
+	n, err := New(client.Match, store, nil).Sync(context.Background(), "puuid", collect(new([]string)))
+	require.ErrorIs(t, err, api.ErrNotFound)
+	assert.Equal(t, 0, n)
+	cursor, err := store.Load(context.Background(), "puuid")
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+}
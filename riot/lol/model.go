@@ -1,11 +1,15 @@
 package lol
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/datadragon"
 	"github.com/KnutZuidema/golio/static"
 )
@@ -54,10 +58,14 @@ type ChampionMastery struct {
 	TokensEarned                 int    `json:"tokensEarned"`
 	ChampionPointsSinceLastLevel int    `json:"championPointsSinceLastLevel"`
 	SummonerID                   string `json:"summonerId"`
+	PUUID                        string `json:"puuid"`
 }
 
 // GetSummoner returns the summoner of this mastery
 func (m *ChampionMastery) GetSummoner(client *Client) (*Summoner, error) {
+	if m.PUUID != "" {
+		return client.Summoner.GetByPUUID(m.PUUID)
+	}
 	return client.Summoner.GetByID(m.SummonerID)
 }
 
@@ -90,8 +98,24 @@ func (l *LeagueList) GetRank(i int) *LeagueItem {
 	return l.sortedEntries[i]
 }
 
+// Items returns copies of the entries sorted by league points, completed with the league ID, queue and tier of
+// the league which are not set on the entries of a league
+func (l *LeagueList) Items() []*LeagueItem {
+	items := make([]*LeagueItem, 0, len(l.Entries))
+	for i := range l.Entries {
+		item := *l.GetRank(i)
+		item.LeagueID = l.LeagueID
+		item.QueueType = l.Queue
+		item.Tier = l.Tier
+		items = append(items, &item)
+	}
+	return items
+}
+
 // LeagueItem represents a summoners ranked position in a league
 type LeagueItem struct {
+	LeagueID     string      `json:"leagueId"`
+	PUUID        string      `json:"puuid"`
 	QueueType    string      `json:"queueType"`
 	SummonerName string      `json:"summonerName"`
 	HotStreak    bool        `json:"hotStreak"`
@@ -109,6 +133,9 @@ type LeagueItem struct {
 
 // GetSummoner returns the summoner of this league item
 func (i *LeagueItem) GetSummoner(client *Client) (*Summoner, error) {
+	if i.PUUID != "" {
+		return client.Summoner.GetByPUUID(i.PUUID)
+	}
 	return client.Summoner.GetByID(i.SummonerID)
 }
 
@@ -140,6 +167,8 @@ type MatchMetadata struct {
 
 // MatchInfo contains the data for a specific match
 type MatchInfo struct {
+	// Refer to indicate if the game ended in termination.
+	EndOfGameResult string `json:"endOfGameResult"`
 	// Unix timestamp for when the game is created on the game server (i.e., the loading screen).
 	GameCreation int64 `json:"gameCreation"`
 	// Prior to patch 11.20, this field returns the game length in milliseconds calculated
@@ -178,6 +207,59 @@ type MatchInfo struct {
 	TournamentCode string `json:"tournamentCode"`
 }
 
+// Duration returns the length of the match. GameDuration is treated as milliseconds for matches played before
+// GameEndTimestamp was added and as seconds afterwards.
+func (m *MatchInfo) Duration() time.Duration {
+	if m.GameEndTimestamp == 0 {
+		return time.Duration(m.GameDuration) * time.Millisecond
+	}
+	return time.Duration(m.GameDuration) * time.Second
+}
+
+// IsArena returns whether the match was played in Arena
+func (m *MatchInfo) IsArena() bool {
+	return m.GameMode == GameModeArena
+}
+
+// Subteam is a group of participants playing together in modes with more than two teams, like Arena
+type Subteam struct {
+	ID int
+	// Placement of the subteam, 1 being the winner
+	Placement    int
+	Participants []*Participant
+}
+
+// Subteams groups the participants by subteam, ranked by placement. Participants without a subteam are left out,
+// so the result is empty for modes without subteams.
+func (m *MatchInfo) Subteams() []*Subteam {
+	var subteams []*Subteam
+	byID := map[int]*Subteam{}
+	for _, p := range m.Participants {
+		if p.PlayerSubteamID == 0 {
+			continue
+		}
+		subteam, ok := byID[p.PlayerSubteamID]
+		if !ok {
+			subteam = &Subteam{ID: p.PlayerSubteamID}
+			byID[p.PlayerSubteamID] = subteam
+			subteams = append(subteams, subteam)
+		}
+		if subteam.Placement == 0 {
+			subteam.Placement = p.SubteamPlacement
+		}
+		subteam.Participants = append(subteam.Participants, p)
+	}
+	sort.SliceStable(subteams, func(i, j int) bool {
+		a, b := subteams[i].Placement, subteams[j].Placement
+		if a == 0 || b == 0 {
+			// unknown placements go last
+			return a != 0 && b == 0
+		}
+		return a < b
+	})
+	return subteams
+}
+
 // GetQueue returns the queue this match was played in
 func (m *MatchInfo) GetQueue(client *static.Client) (static.Queue, error) {
 	return client.GetQueue(m.QueueID)
@@ -228,11 +310,17 @@ type ParticipantPerks struct {
 
 // Participant hold information for a participant of a match
 type Participant struct {
-	Assists         int `json:"assists"`
-	BaronKills      int `json:"baronKills"`
-	BountyLevel     int `json:"bountyLevel"`
-	ChampExperience int `json:"champExperience"`
-	ChampLevel      int `json:"champLevel"`
+	AllInPings    int `json:"allInPings"`
+	AssistMePings int `json:"assistMePings"`
+	Assists       int `json:"assists"`
+	BaitPings     int `json:"baitPings"`
+	BaronKills    int `json:"baronKills"`
+	BasicPings    int `json:"basicPings"`
+	BountyLevel   int `json:"bountyLevel"`
+	// Challenges are only present for matches played since patch 12.12.
+	Challenges      *ParticipantChallenges `json:"challenges"`
+	ChampExperience int                    `json:"champExperience"`
+	ChampLevel      int                    `json:"champLevel"`
 	// Prior to patch 11.4, on Feb 18th, 2021, this field returned invalid championIds.
 	// We recommend determining the champion based on the championName field for matches played prior to patch 11.4.
 	ChampionID   int    `json:"championId"`
@@ -240,23 +328,30 @@ type Participant struct {
 	// This field is currently only utilized for Kayn's transformations.
 	// (Legal values: 0 - None, 1 - Slayer, 2 - Assassin)
 	ChampionTransform         int  `json:"championTransform"`
+	CommandPings              int  `json:"commandPings"`
 	ConsumablesPurchased      int  `json:"consumablesPurchased"`
 	DamageDealtToBuildings    int  `json:"damageDealtToBuildings"`
 	DamageDealtToObjectives   int  `json:"damageDealtToObjectives"`
 	DamageDealtToTurrets      int  `json:"damageDealtToTurrets"`
 	DamageSelfMitigated       int  `json:"damageSelfMitigated"`
+	DangerPings               int  `json:"dangerPings"`
 	Deaths                    int  `json:"deaths"`
 	DetectorWardsPlaced       int  `json:"detectorWardsPlaced"`
 	DoubleKills               int  `json:"doubleKills"`
 	DragonKills               int  `json:"dragonKills"`
+	EligibleForProgression    bool `json:"eligibleForProgression"`
+	EnemyMissingPings         int  `json:"enemyMissingPings"`
+	EnemyVisionPings          int  `json:"enemyVisionPings"`
 	FirstBloodAssist          bool `json:"firstBloodAssist"`
 	FirstBloodKill            bool `json:"firstBloodKill"`
 	FirstTowerAssist          bool `json:"firstTowerAssist"`
 	FirstTowerKill            bool `json:"firstTowerKill"`
 	GameEndedInEarlySurrender bool `json:"gameEndedInEarlySurrender"`
 	GameEndedInSurrender      bool `json:"gameEndedInSurrender"`
+	GetBackPings              int  `json:"getBackPings"`
 	GoldEarned                int  `json:"goldEarned"`
 	GoldSpent                 int  `json:"goldSpent"`
+	HoldPings                 int  `json:"holdPings"`
 	// Both individualPosition and teamPosition are computed by the game server and are
 	// different versions of the most likely position played by a player. The individualPosition
 	// is the best guess for which position the player actually played in isolation of
@@ -264,61 +359,80 @@ type Participant struct {
 	// actually played if we add the constraint that each team must have one top player, one
 	// jungle, one middle, etc. Generally the recommendation is to use the teamPosition field
 	// over the individualPosition field.
-	IndividualPosition             string            `json:"individualPosition"`
-	InhibitorKills                 int               `json:"inhibitorKills"`
-	InhibitorTakedowns             int               `json:"inhibitorTakedowns"`
-	InhibitorsLost                 int               `json:"inhibitorsLost"`
-	Item0                          int               `json:"item0"`
-	Item1                          int               `json:"item1"`
-	Item2                          int               `json:"item2"`
-	Item3                          int               `json:"item3"`
-	Item4                          int               `json:"item4"`
-	Item5                          int               `json:"item5"`
-	Item6                          int               `json:"item6"`
-	ItemsPurchased                 int               `json:"itemsPurchased"`
-	KillingSprees                  int               `json:"killingSprees"`
-	Kills                          int               `json:"kills"`
-	Lane                           string            `json:"lane"`
-	LargestCriticalStrike          int               `json:"largestCriticalStrike"`
-	LargestKillingSpree            int               `json:"largestKillingSpree"`
-	LargestMultiKill               int               `json:"largestMultiKill"`
-	LongestTimeSpentLiving         int               `json:"longestTimeSpentLiving"`
-	MagicDamageDealt               int               `json:"magicDamageDealt"`
-	MagicDamageDealtToChampions    int               `json:"magicDamageDealtToChampions"`
-	MagicDamageTaken               int               `json:"magicDamageTaken"`
-	NeutralMinionsKilled           int               `json:"neutralMinionsKilled"`
-	NexusKills                     int               `json:"nexusKills"`
-	NexusLost                      int               `json:"nexusLost"`
-	NexusTakedowns                 int               `json:"nexusTakedowns"`
-	ObjectivesStolen               int               `json:"objectivesStolen"`
-	ObjectivesStolenAssists        int               `json:"objectivesStolenAssists"`
-	ParticipantID                  int               `json:"participantId"`
-	PentaKills                     int               `json:"pentaKills"`
-	Perks                          *ParticipantPerks `json:"perks"`
-	PhysicalDamageDealt            int               `json:"physicalDamageDealt"`
-	PhysicalDamageDealtToChampions int               `json:"physicalDamageDealtToChampions"`
-	PhysicalDamageTaken            int               `json:"physicalDamageTaken"`
-	ProfileIcon                    int               `json:"profileIcon"`
-	PUUID                          string            `json:"puuid"`
-	QuadraKills                    int               `json:"quadraKills"`
-	RiotIDGameName                 string            `json:"riotIdGameName"`
-	RiotIDName                     string            `json:"riotIdName"`
-	RiotIDTagline                  string            `json:"riotIdTagline"`
-	Role                           string            `json:"role"`
-	SightWardsBoughtInGame         int               `json:"sightWardsBoughtInGame"`
-	Spell1Casts                    int               `json:"spell1Casts"`
-	Spell2Casts                    int               `json:"spell2Casts"`
-	Spell3Casts                    int               `json:"spell3Casts"`
-	Spell4Casts                    int               `json:"spell4Casts"`
-	Summoner1Casts                 int               `json:"summoner1Casts"`
-	Summoner1ID                    int               `json:"summoner1Id"`
-	Summoner2Casts                 int               `json:"summoner2Casts"`
-	Summoner2ID                    int               `json:"summoner2Id"`
-	SummonerID                     string            `json:"summonerId"`
-	SummonerLevel                  int               `json:"summonerLevel"`
-	SummonerName                   string            `json:"summonerName"`
-	TeamEarlySurrendered           bool              `json:"teamEarlySurrendered"`
-	TeamID                         int               `json:"teamId"`
+	IndividualPosition             string               `json:"individualPosition"`
+	InhibitorKills                 int                  `json:"inhibitorKills"`
+	InhibitorTakedowns             int                  `json:"inhibitorTakedowns"`
+	InhibitorsLost                 int                  `json:"inhibitorsLost"`
+	Item0                          int                  `json:"item0"`
+	Item1                          int                  `json:"item1"`
+	Item2                          int                  `json:"item2"`
+	Item3                          int                  `json:"item3"`
+	Item4                          int                  `json:"item4"`
+	Item5                          int                  `json:"item5"`
+	Item6                          int                  `json:"item6"`
+	ItemsPurchased                 int                  `json:"itemsPurchased"`
+	KillingSprees                  int                  `json:"killingSprees"`
+	Kills                          int                  `json:"kills"`
+	Lane                           string               `json:"lane"`
+	LargestCriticalStrike          int                  `json:"largestCriticalStrike"`
+	LargestKillingSpree            int                  `json:"largestKillingSpree"`
+	LargestMultiKill               int                  `json:"largestMultiKill"`
+	LongestTimeSpentLiving         int                  `json:"longestTimeSpentLiving"`
+	MagicDamageDealt               int                  `json:"magicDamageDealt"`
+	MagicDamageDealtToChampions    int                  `json:"magicDamageDealtToChampions"`
+	MagicDamageTaken               int                  `json:"magicDamageTaken"`
+	Missions                       *ParticipantMissions `json:"missions"`
+	NeedVisionPings                int                  `json:"needVisionPings"`
+	NeutralMinionsKilled           int                  `json:"neutralMinionsKilled"`
+	NexusKills                     int                  `json:"nexusKills"`
+	NexusLost                      int                  `json:"nexusLost"`
+	NexusTakedowns                 int                  `json:"nexusTakedowns"`
+	ObjectivesStolen               int                  `json:"objectivesStolen"`
+	ObjectivesStolenAssists        int                  `json:"objectivesStolenAssists"`
+	OnMyWayPings                   int                  `json:"onMyWayPings"`
+	ParticipantID                  int                  `json:"participantId"`
+	PentaKills                     int                  `json:"pentaKills"`
+	Perks                          *ParticipantPerks    `json:"perks"`
+	PhysicalDamageDealt            int                  `json:"physicalDamageDealt"`
+	PhysicalDamageDealtToChampions int                  `json:"physicalDamageDealtToChampions"`
+	PhysicalDamageTaken            int                  `json:"physicalDamageTaken"`
+	// Placement of the player in Arena, 0 for other game modes.
+	Placement int `json:"placement"`
+	// Augments chosen by the player in Arena.
+	PlayerAugment1 int `json:"playerAugment1"`
+	PlayerAugment2 int `json:"playerAugment2"`
+	PlayerAugment3 int `json:"playerAugment3"`
+	PlayerAugment4 int `json:"playerAugment4"`
+	PlayerAugment5 int `json:"playerAugment5"`
+	PlayerAugment6 int `json:"playerAugment6"`
+	// Arena team of the player.
+	PlayerSubteamID        int    `json:"playerSubteamId"`
+	ProfileIcon            int    `json:"profileIcon"`
+	PushPings              int    `json:"pushPings"`
+	PUUID                  string `json:"puuid"`
+	QuadraKills            int    `json:"quadraKills"`
+	RetreatPings           int    `json:"retreatPings"`
+	RiotIDGameName         string `json:"riotIdGameName"`
+	RiotIDName             string `json:"riotIdName"`
+	RiotIDTagline          string `json:"riotIdTagline"`
+	Role                   string `json:"role"`
+	RoleBoundItem          int    `json:"roleBoundItem"`
+	SightWardsBoughtInGame int    `json:"sightWardsBoughtInGame"`
+	Spell1Casts            int    `json:"spell1Casts"`
+	Spell2Casts            int    `json:"spell2Casts"`
+	Spell3Casts            int    `json:"spell3Casts"`
+	Spell4Casts            int    `json:"spell4Casts"`
+	// Placement of the Arena team of the player.
+	SubteamPlacement     int    `json:"subteamPlacement"`
+	Summoner1Casts       int    `json:"summoner1Casts"`
+	Summoner1ID          int    `json:"summoner1Id"`
+	Summoner2Casts       int    `json:"summoner2Casts"`
+	Summoner2ID          int    `json:"summoner2Id"`
+	SummonerID           string `json:"summonerId"`
+	SummonerLevel        int    `json:"summonerLevel"`
+	SummonerName         string `json:"summonerName"`
+	TeamEarlySurrendered bool   `json:"teamEarlySurrendered"`
+	TeamID               int    `json:"teamId"`
 	// Both individualPosition and teamPosition are computed by the game server and are
 	// different versions of the most likely position played by a player. The individualPosition
 	// is the best guess for which position the player actually played in isolation of
@@ -329,10 +443,12 @@ type Participant struct {
 	TeamPosition                   string `json:"teamPosition"`
 	TimeCCingOthers                int    `json:"timeCCingOthers"`
 	TimePlayed                     int    `json:"timePlayed"`
+	TotalAllyJungleMinionsKilled   int    `json:"totalAllyJungleMinionsKilled"`
 	TotalDamageDealt               int    `json:"totalDamageDealt"`
 	TotalDamageDealtToChampions    int    `json:"totalDamageDealtToChampions"`
 	TotalDamageShieldedOnTeammates int    `json:"totalDamageShieldedOnTeammates"`
 	TotalDamageTaken               int    `json:"totalDamageTaken"`
+	TotalEnemyJungleMinionsKilled  int    `json:"totalEnemyJungleMinionsKilled"`
 	TotalHeal                      int    `json:"totalHeal"`
 	TotalHealsOnTeammates          int    `json:"totalHealsOnTeammates"`
 	TotalMinionsKilled             int    `json:"totalMinionsKilled"`
@@ -347,6 +463,7 @@ type Participant struct {
 	TurretTakedowns                int    `json:"turretTakedowns"`
 	TurretsLost                    int    `json:"turretsLost"`
 	UnrealKills                    int    `json:"unrealKills"`
+	VisionClearedPings             int    `json:"visionClearedPings"`
 	VisionScore                    int    `json:"visionScore"`
 	VisionWardsBoughtInGame        int    `json:"visionWardsBoughtInGame"`
 	WardsKilled                    int    `json:"wardsKilled"`
@@ -414,6 +531,189 @@ func (p *Participant) GetItem6(client *datadragon.Client) (datadragon.Item, erro
 	return client.GetItem(strconv.Itoa(p.Item6))
 }
 
+// Augments returns the IDs of the augments chosen by this participant in Arena
+func (p *Participant) Augments() []int {
+	var augments []int
+	for _, id := range []int{
+		p.PlayerAugment1, p.PlayerAugment2, p.PlayerAugment3, p.PlayerAugment4, p.PlayerAugment5, p.PlayerAugment6,
+	} {
+		if id != 0 {
+			augments = append(augments, id)
+		}
+	}
+	return augments
+}
+
+// GetAugments returns the augments chosen by this participant in Arena
+func (p *Participant) GetAugments(client *static.Client) ([]static.Augment, error) {
+	var augments []static.Augment
+	for _, id := range p.Augments() {
+		augment, err := client.GetAugment(id)
+		if err != nil {
+			return nil, err
+		}
+		augments = append(augments, augment)
+	}
+	return augments, nil
+}
+
+// ParticipantChallenges holds the progress of a participant for the challenges of a match. Metrics only
+// present for some game modes or roles are zero otherwise.
+type ParticipantChallenges struct {
+	TwelveAssistStreakCount                   int     `json:"12AssistStreakCount"`
+	HealFromMapSources                        float64 `json:"HealFromMapSources"`
+	InfernalScalePickup                       int     `json:"InfernalScalePickup"`
+	AbilityUses                               int     `json:"abilityUses"`
+	AcesBefore15Minutes                       int     `json:"acesBefore15Minutes"`
+	AlliedJungleMonsterKills                  float64 `json:"alliedJungleMonsterKills"`
+	BaronBuffGoldAdvantageOverThreshold       int     `json:"baronBuffGoldAdvantageOverThreshold"`
+	BaronTakedowns                            int     `json:"baronTakedowns"`
+	BlastConeOppositeOpponentCount            int     `json:"blastConeOppositeOpponentCount"`
+	BountyGold                                float64 `json:"bountyGold"`
+	BuffsStolen                               int     `json:"buffsStolen"`
+	CompleteSupportQuestInTime                int     `json:"completeSupportQuestInTime"`
+	ControlWardTimeCoverageInRiverOrEnemyHalf float64 `json:"controlWardTimeCoverageInRiverOrEnemyHalf"`
+	ControlWardsPlaced                        int     `json:"controlWardsPlaced"`
+	DamagePerMinute                           float64 `json:"damagePerMinute"`
+	DamageTakenOnTeamPercentage               float64 `json:"damageTakenOnTeamPercentage"`
+	DancedWithRiftHerald                      int     `json:"dancedWithRiftHerald"`
+	DeathsByEnemyChamps                       int     `json:"deathsByEnemyChamps"`
+	DodgeSkillShotsSmallWindow                int     `json:"dodgeSkillShotsSmallWindow"`
+	DoubleAces                                int     `json:"doubleAces"`
+	DragonTakedowns                           int     `json:"dragonTakedowns"`
+	EarliestBaron                             float64 `json:"earliestBaron"`
+	EarliestDragonTakedown                    float64 `json:"earliestDragonTakedown"`
+	EarliestElderDragon                       float64 `json:"earliestElderDragon"`
+	EarlyLaningPhaseGoldExpAdvantage          float64 `json:"earlyLaningPhaseGoldExpAdvantage"`
+	EffectiveHealAndShielding                 float64 `json:"effectiveHealAndShielding"`
+	ElderDragonKillsWithOpposingSoul          int     `json:"elderDragonKillsWithOpposingSoul"`
+	ElderDragonMultikills                     int     `json:"elderDragonMultikills"`
+	EnemyChampionImmobilizations              int     `json:"enemyChampionImmobilizations"`
+	EnemyJungleMonsterKills                   float64 `json:"enemyJungleMonsterKills"`
+	EpicMonsterKillsNearEnemyJungler          int     `json:"epicMonsterKillsNearEnemyJungler"`
+	EpicMonsterKillsWithin30SecondsOfSpawn    int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
+	EpicMonsterSteals                         int     `json:"epicMonsterSteals"`
+	EpicMonsterStolenWithoutSmite             int     `json:"epicMonsterStolenWithoutSmite"`
+	FasterSupportQuestCompletion              int     `json:"fasterSupportQuestCompletion"`
+	FastestLegendary                          float64 `json:"fastestLegendary"`
+	FirstTurretKilled                         int     `json:"firstTurretKilled"`
+	FirstTurretKilledTime                     float64 `json:"firstTurretKilledTime"`
+	FistBumpParticipation                     int     `json:"fistBumpParticipation"`
+	FlawlessAces                              int     `json:"flawlessAces"`
+	FullTeamTakedown                          int     `json:"fullTeamTakedown"`
+	GameLength                                float64 `json:"gameLength"`
+	GetTakedownsInAllLanesEarlyJungleAsLaner  int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
+	GoldPerMinute                             float64 `json:"goldPerMinute"`
+	HadAFKTeammate                            int     `json:"hadAfkTeammate"`
+	HadOpenNexus                              int     `json:"hadOpenNexus"`
+	HighestChampionDamage                     int     `json:"highestChampionDamage"`
+	HighestCrowdControlScore                  int     `json:"highestCrowdControlScore"`
+	HighestWardKills                          int     `json:"highestWardKills"`
+	ImmobilizeAndKillWithAlly                 int     `json:"immobilizeAndKillWithAlly"`
+	InitialBuffCount                          int     `json:"initialBuffCount"`
+	InitialCrabCount                          int     `json:"initialCrabCount"`
+	JungleCSBefore10Minutes                   float64 `json:"jungleCsBefore10Minutes"`
+	JunglerKillsEarlyJungle                   int     `json:"junglerKillsEarlyJungle"`
+	JunglerTakedownsNearDamagedEpicMonster    int     `json:"junglerTakedownsNearDamagedEpicMonster"`
+	KTurretsDestroyedBeforePlatesFall         int     `json:"kTurretsDestroyedBeforePlatesFall"`
+	KDA                                       float64 `json:"kda"`
+	KillAfterHiddenWithAlly                   int     `json:"killAfterHiddenWithAlly"`
+	KillParticipation                         float64 `json:"killParticipation"`
+	KilledChampTookFullTeamDamageSurvived     int     `json:"killedChampTookFullTeamDamageSurvived"`
+	KillingSprees                             int     `json:"killingSprees"`
+	KillsNearEnemyTurret                      int     `json:"killsNearEnemyTurret"`
+	KillsOnLanersEarlyJungleAsJungler         int     `json:"killsOnLanersEarlyJungleAsJungler"`
+	KillsOnOtherLanesEarlyJungleAsLaner       int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
+	KillsOnRecentlyHealedByAramPack           int     `json:"killsOnRecentlyHealedByAramPack"`
+	KillsUnderOwnTurret                       int     `json:"killsUnderOwnTurret"`
+	KillsWithHelpFromEpicMonster              int     `json:"killsWithHelpFromEpicMonster"`
+	KnockEnemyIntoTeamAndKill                 int     `json:"knockEnemyIntoTeamAndKill"`
+	LandSkillShotsEarlyGame                   int     `json:"landSkillShotsEarlyGame"`
+	LaneMinionsFirst10Minutes                 int     `json:"laneMinionsFirst10Minutes"`
+	LaningPhaseGoldExpAdvantage               float64 `json:"laningPhaseGoldExpAdvantage"`
+	LegendaryCount                            int     `json:"legendaryCount"`
+	LegendaryItemUsed                         []int   `json:"legendaryItemUsed"`
+	LostAnInhibitor                           int     `json:"lostAnInhibitor"`
+	MaxCSAdvantageOnLaneOpponent              float64 `json:"maxCsAdvantageOnLaneOpponent"`
+	MaxKillDeficit                            int     `json:"maxKillDeficit"`
+	MaxLevelLeadLaneOpponent                  int     `json:"maxLevelLeadLaneOpponent"`
+	MejaisFullStackInTime                     int     `json:"mejaisFullStackInTime"`
+	MoreEnemyJungleThanOpponent               float64 `json:"moreEnemyJungleThanOpponent"`
+	MultiKillOneSpell                         int     `json:"multiKillOneSpell"`
+	MultiTurretRiftHeraldCount                int     `json:"multiTurretRiftHeraldCount"`
+	Multikills                                int     `json:"multikills"`
+	MultikillsAfterAggressiveFlash            int     `json:"multikillsAfterAggressiveFlash"`
+	MythicItemUsed                            int     `json:"mythicItemUsed"`
+	OuterTurretExecutesBefore10Minutes        int     `json:"outerTurretExecutesBefore10Minutes"`
+	OutnumberedKills                          int     `json:"outnumberedKills"`
+	OutnumberedNexusKill                      int     `json:"outnumberedNexusKill"`
+	PerfectDragonSoulsTaken                   int     `json:"perfectDragonSoulsTaken"`
+	PerfectGame                               int     `json:"perfectGame"`
+	PickKillWithAlly                          int     `json:"pickKillWithAlly"`
+	PlayedChampSelectPosition                 int     `json:"playedChampSelectPosition"`
+	PoroExplosions                            int     `json:"poroExplosions"`
+	QuickCleanse                              int     `json:"quickCleanse"`
+	QuickFirstTurret                          int     `json:"quickFirstTurret"`
+	QuickSoloKills                            int     `json:"quickSoloKills"`
+	RiftHeraldTakedowns                       int     `json:"riftHeraldTakedowns"`
+	SaveAllyFromDeath                         int     `json:"saveAllyFromDeath"`
+	ScuttleCrabKills                          int     `json:"scuttleCrabKills"`
+	ShortestTimeToAceFromFirstTakedown        float64 `json:"shortestTimeToAceFromFirstTakedown"`
+	SkillshotsDodged                          int     `json:"skillshotsDodged"`
+	SkillshotsHit                             int     `json:"skillshotsHit"`
+	SnowballsHit                              int     `json:"snowballsHit"`
+	SoloBaronKills                            int     `json:"soloBaronKills"`
+	SoloKills                                 int     `json:"soloKills"`
+	SoloTurretsLategame                       int     `json:"soloTurretsLategame"`
+	StealthWardsPlaced                        int     `json:"stealthWardsPlaced"`
+	SurvivedSingleDigitHPCount                int     `json:"survivedSingleDigitHpCount"`
+	SurvivedThreeImmobilizesInFight           int     `json:"survivedThreeImmobilizesInFight"`
+	TakedownOnFirstTurret                     int     `json:"takedownOnFirstTurret"`
+	Takedowns                                 int     `json:"takedowns"`
+	TakedownsAfterGainingLevelAdvantage       int     `json:"takedownsAfterGainingLevelAdvantage"`
+	TakedownsBeforeJungleMinionSpawn          int     `json:"takedownsBeforeJungleMinionSpawn"`
+	TakedownsFirst25Minutes                   int     `json:"takedownsFirst25Minutes"`
+	TakedownsFirstXMinutes                    int     `json:"takedownsFirstXMinutes"`
+	TakedownsInAlcove                         int     `json:"takedownsInAlcove"`
+	TakedownsInEnemyFountain                  int     `json:"takedownsInEnemyFountain"`
+	TeamBaronKills                            int     `json:"teamBaronKills"`
+	TeamDamagePercentage                      float64 `json:"teamDamagePercentage"`
+	TeamElderDragonKills                      int     `json:"teamElderDragonKills"`
+	TeamRiftHeraldKills                       int     `json:"teamRiftHeraldKills"`
+	TeleportTakedowns                         int     `json:"teleportTakedowns"`
+	ThirdInhibitorDestroyedTime               float64 `json:"thirdInhibitorDestroyedTime"`
+	ThreeWardsOneSweeperCount                 int     `json:"threeWardsOneSweeperCount"`
+	TookLargeDamageSurvived                   int     `json:"tookLargeDamageSurvived"`
+	TurretPlatesTaken                         int     `json:"turretPlatesTaken"`
+	TurretTakedowns                           int     `json:"turretTakedowns"`
+	TurretsTakenWithRiftHerald                int     `json:"turretsTakenWithRiftHerald"`
+	TwentyMinionsIn3SecondsCount              int     `json:"twentyMinionsIn3SecondsCount"`
+	TwoWardsOneSweeperCount                   int     `json:"twoWardsOneSweeperCount"`
+	UnseenRecalls                             int     `json:"unseenRecalls"`
+	VisionScoreAdvantageLaneOpponent          float64 `json:"visionScoreAdvantageLaneOpponent"`
+	VisionScorePerMinute                      float64 `json:"visionScorePerMinute"`
+	VoidMonsterKill                           int     `json:"voidMonsterKill"`
+	WardTakedowns                             int     `json:"wardTakedowns"`
+	WardTakedownsBefore20M                    int     `json:"wardTakedownsBefore20M"`
+	WardsGuarded                              int     `json:"wardsGuarded"`
+}
+
+// ParticipantMissions holds the mission scores of a participant
+type ParticipantMissions struct {
+	PlayerScore0  float64 `json:"playerScore0"`
+	PlayerScore1  float64 `json:"playerScore1"`
+	PlayerScore2  float64 `json:"playerScore2"`
+	PlayerScore3  float64 `json:"playerScore3"`
+	PlayerScore4  float64 `json:"playerScore4"`
+	PlayerScore5  float64 `json:"playerScore5"`
+	PlayerScore6  float64 `json:"playerScore6"`
+	PlayerScore7  float64 `json:"playerScore7"`
+	PlayerScore8  float64 `json:"playerScore8"`
+	PlayerScore9  float64 `json:"playerScore9"`
+	PlayerScore10 float64 `json:"playerScore10"`
+	PlayerScore11 float64 `json:"playerScore11"`
+}
+
 // TeamBan is a champion banned by a team
 type TeamBan struct {
 	// Turn during which the champion was banned.
@@ -435,47 +735,198 @@ type Objective struct {
 
 // Objectives holds info for a teeam's objeectives
 type Objectives struct {
-	Baron      Objective `json:"baron"`
-	Champion   Objective `json:"champion"`
-	Dragon     Objective `json:"dragon"`
+	Atakhan  Objective `json:"atakhan"`
+	Baron    Objective `json:"baron"`
+	Champion Objective `json:"champion"`
+	Dragon   Objective `json:"dragon"`
+	// Horde are the void grubs
+	Horde      Objective `json:"horde"`
 	Inhibitor  Objective `json:"inhibitor"`
 	RiftHerald Objective `json:"riftHerald"`
 	Tower      Objective `json:"tower"`
 }
 
+// Feat holds the progress of a team for a single feat of strength
+type Feat struct {
+	FeatState int `json:"featState"`
+}
+
+// Feats holds the progress of a team for the feats of strength
+type Feats struct {
+	EpicMonsterKill Feat `json:"EPIC_MONSTER_KILL"`
+	FirstBlood      Feat `json:"FIRST_BLOOD"`
+	FirstTurret     Feat `json:"FIRST_TURRET"`
+}
+
 // Team holds information for a team in a match
 type Team struct {
-	Bans       []*TeamBan `json:"bans"`
+	Bans []*TeamBan `json:"bans"`
+	// Feats are only present for matches played since patch 25.1.
+	Feats      *Feats     `json:"feats,omitempty"`
 	Objectives Objectives `json:"objectives"`
 	TeamID     int        `json:"teamId"`
 	Win        bool       `json:"win"`
 }
 
-// MatchTimeline contains timeline frames for a match
+// MatchTimeline contains the timeline of a match
 type MatchTimeline struct {
-	Frames   []*MatchFrame `json:"frames"`
-	Interval int           `json:"frameInterval"`
+	Metadata *MatchMetadata     `json:"metadata"`
+	Info     *MatchTimelineInfo `json:"info"`
+}
+
+// Events returns all events of the timeline in chronological order
+func (t *MatchTimeline) Events() []MatchEvent {
+	var events []MatchEvent
+	if t.Info == nil {
+		return events
+	}
+	for _, frame := range t.Info.Frames {
+		events = append(events, frame.Events...)
+	}
+	return events
+}
+
+// EventsOfType returns all events of the timeline with one of the given types in chronological order
+func (t *MatchTimeline) EventsOfType(types ...MatchEventType) []MatchEvent {
+	var events []MatchEvent
+	for _, event := range t.Events() {
+		for _, eventType := range types {
+			if event.EventType() == eventType {
+				events = append(events, event)
+				break
+			}
+		}
+	}
+	return events
+}
+
+// FilterEvents returns all events of the timeline of the type T in chronological order,
+// e.g. FilterEvents[*ChampionKillEvent](timeline)
+func FilterEvents[T MatchEvent](timeline *MatchTimeline) []T {
+	var events []T
+	for _, event := range timeline.Events() {
+		if e, ok := event.(T); ok {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// Visit passes all events of the timeline in chronological order to the visitor
+func (t *MatchTimeline) Visit(visitor *MatchEventVisitor) {
+	for _, event := range t.Events() {
+		visitor.Visit(event)
+	}
+}
+
+// MatchTimelineInfo contains the frames of a match timeline
+type MatchTimelineInfo struct {
+	EndOfGameResult string                 `json:"endOfGameResult"`
+	FrameInterval   int                    `json:"frameInterval"`
+	GameID          int                    `json:"gameId"`
+	Participants    []*TimelineParticipant `json:"participants"`
+	Frames          []*MatchFrame          `json:"frames"`
+}
+
+// TimelineParticipant maps the participant ID used in a timeline to the PUUID of the player
+type TimelineParticipant struct {
+	ParticipantID int    `json:"participantId"`
+	PUUID         string `json:"puuid"`
 }
 
 // MatchFrame is a single frame in the timeline of a game
 type MatchFrame struct {
-	Timestamp         int                          `json:"timestamp"`
+	Timestamp int `json:"timestamp"`
+	// ParticipantFrames are keyed by the participant ID
 	ParticipantFrames map[string]*ParticipantFrame `json:"participantFrames"`
-	Events            []*MatchEvent                `json:"events"`
+	Events            []MatchEvent                 `json:"events"`
+}
+
+// UnmarshalJSON decodes every event of the frame into the type matching its event type
+func (f *MatchFrame) UnmarshalJSON(data []byte) error {
+	var frame struct {
+		Timestamp         int                          `json:"timestamp"`
+		ParticipantFrames map[string]*ParticipantFrame `json:"participantFrames"`
+		Events            []json.RawMessage            `json:"events"`
+	}
+	if err := json.Unmarshal(data, &frame); err != nil {
+		return err
+	}
+	f.Timestamp = frame.Timestamp
+	f.ParticipantFrames = frame.ParticipantFrames
+	f.Events = nil
+	if frame.Events != nil {
+		f.Events = make([]MatchEvent, 0, len(frame.Events))
+	}
+	for _, raw := range frame.Events {
+		event, err := UnmarshalMatchEvent(raw)
+		if err != nil {
+			return err
+		}
+		f.Events = append(f.Events, event)
+	}
+	return nil
 }
 
 // ParticipantFrame contains information about a participant in a game at a single timestamp
 type ParticipantFrame struct {
-	TotalGold           int            `json:"totalGold"`
-	TeamScore           int            `json:"teamScore"`
-	ParticipantID       int            `json:"participantId"`
-	Level               int            `json:"level"`
-	CurrentGold         int            `json:"currentGold"`
-	MinionsKilled       int            `json:"minionsKilled"`
-	DominionScore       int            `json:"dominionScore"`
-	Position            *MatchPosition `json:"position"`
-	XP                  int            `json:"xp"`
-	JungleMinionsKilled int            `json:"jungleMinionsKilled"`
+	ChampionStats            *ChampionStats `json:"championStats"`
+	CurrentGold              int            `json:"currentGold"`
+	DamageStats              *DamageStats   `json:"damageStats"`
+	GoldPerSecond            int            `json:"goldPerSecond"`
+	JungleMinionsKilled      int            `json:"jungleMinionsKilled"`
+	Level                    int            `json:"level"`
+	MinionsKilled            int            `json:"minionsKilled"`
+	ParticipantID            int            `json:"participantId"`
+	Position                 *MatchPosition `json:"position"`
+	TimeEnemySpentControlled int            `json:"timeEnemySpentControlled"`
+	TotalGold                int            `json:"totalGold"`
+	XP                       int            `json:"xp"`
+}
+
+// ChampionStats contains the stats of the champion of a participant at a single timestamp
+type ChampionStats struct {
+	AbilityHaste         int `json:"abilityHaste"`
+	AbilityPower         int `json:"abilityPower"`
+	Armor                int `json:"armor"`
+	ArmorPen             int `json:"armorPen"`
+	ArmorPenPercent      int `json:"armorPenPercent"`
+	AttackDamage         int `json:"attackDamage"`
+	AttackSpeed          int `json:"attackSpeed"`
+	BonusArmorPenPercent int `json:"bonusArmorPenPercent"`
+	BonusMagicPenPercent int `json:"bonusMagicPenPercent"`
+	CCReduction          int `json:"ccReduction"`
+	CooldownReduction    int `json:"cooldownReduction"`
+	Health               int `json:"health"`
+	HealthMax            int `json:"healthMax"`
+	HealthRegen          int `json:"healthRegen"`
+	Lifesteal            int `json:"lifesteal"`
+	MagicPen             int `json:"magicPen"`
+	MagicPenPercent      int `json:"magicPenPercent"`
+	MagicResist          int `json:"magicResist"`
+	MovementSpeed        int `json:"movementSpeed"`
+	Omnivamp             int `json:"omnivamp"`
+	PhysicalVamp         int `json:"physicalVamp"`
+	Power                int `json:"power"`
+	PowerMax             int `json:"powerMax"`
+	PowerRegen           int `json:"powerRegen"`
+	SpellVamp            int `json:"spellVamp"`
+}
+
+// DamageStats contains the damage dealt and taken by a participant up to a single timestamp
+type DamageStats struct {
+	MagicDamageDone               int `json:"magicDamageDone"`
+	MagicDamageDoneToChampions    int `json:"magicDamageDoneToChampions"`
+	MagicDamageTaken              int `json:"magicDamageTaken"`
+	PhysicalDamageDone            int `json:"physicalDamageDone"`
+	PhysicalDamageDoneToChampions int `json:"physicalDamageDoneToChampions"`
+	PhysicalDamageTaken           int `json:"physicalDamageTaken"`
+	TotalDamageDone               int `json:"totalDamageDone"`
+	TotalDamageDoneToChampions    int `json:"totalDamageDoneToChampions"`
+	TotalDamageTaken              int `json:"totalDamageTaken"`
+	TrueDamageDone                int `json:"trueDamageDone"`
+	TrueDamageDoneToChampions     int `json:"trueDamageDoneToChampions"`
+	TrueDamageTaken               int `json:"trueDamageTaken"`
 }
 
 // MatchEventType is the type of an event
@@ -483,72 +934,421 @@ type MatchEventType string
 
 // All legal value for match event types
 const (
-	MatchEventTypeChampionKill     MatchEventType = "CHAMPION_KILL"
-	MatchEventTypeWardPlaced                      = "WARD_PLACED"
-	MatchEventTypeWardKill                        = "WARD_KILL"
-	MatchEventTypeBuildingKill                    = "BUILDING_KILL"
-	MatchEventTypeEliteMonsterKill                = "ELITE_MONSTER_KILL"
-	MatchEventTypeItemPurchased                   = "ITEM_PURCHASED"
-	MatchEventTypeItemSold                        = "ITEM_SOLD"
-	MatchEventTypeItemDestroyed                   = "ITEM_DESTROYED"
-	MatchEventTypeItemUndo                        = "ITEM_UNDO"
-	MatchEventTypeSkillLevelUp                    = "SKILL_LEVEL_UP"
-	MatchEventTypeAscendedEvent                   = "ASCENDED_EVENT"
-	MatchEventTypeCapturePoint                    = "CAPTURE_POINT"
-	MatchEventTypePoroKingSummon                  = "PORO_KING_SUMMON"
+	MatchEventTypeChampionKill            MatchEventType = "CHAMPION_KILL"
+	MatchEventTypeChampionSpecialKill     MatchEventType = "CHAMPION_SPECIAL_KILL"
+	MatchEventTypeChampionTransform       MatchEventType = "CHAMPION_TRANSFORM"
+	MatchEventTypeWardPlaced              MatchEventType = "WARD_PLACED"
+	MatchEventTypeWardKill                MatchEventType = "WARD_KILL"
+	MatchEventTypeBuildingKill            MatchEventType = "BUILDING_KILL"
+	MatchEventTypeTurretPlateDestroyed    MatchEventType = "TURRET_PLATE_DESTROYED"
+	MatchEventTypeEliteMonsterKill        MatchEventType = "ELITE_MONSTER_KILL"
+	MatchEventTypeDragonSoulGiven         MatchEventType = "DRAGON_SOUL_GIVEN"
+	MatchEventTypeObjectiveBountyPrestart MatchEventType = "OBJECTIVE_BOUNTY_PRESTART"
+	MatchEventTypeObjectiveBountyFinish   MatchEventType = "OBJECTIVE_BOUNTY_FINISH"
+	MatchEventTypeItemPurchased           MatchEventType = "ITEM_PURCHASED"
+	MatchEventTypeItemSold                MatchEventType = "ITEM_SOLD"
+	MatchEventTypeItemDestroyed           MatchEventType = "ITEM_DESTROYED"
+	MatchEventTypeItemUndo                MatchEventType = "ITEM_UNDO"
+	MatchEventTypeSkillLevelUp            MatchEventType = "SKILL_LEVEL_UP"
+	MatchEventTypeLevelUp                 MatchEventType = "LEVEL_UP"
+	MatchEventTypePauseEnd                MatchEventType = "PAUSE_END"
+	MatchEventTypeFeatUpdate              MatchEventType = "FEAT_UPDATE"
+	MatchEventTypeGameEnd                 MatchEventType = "GAME_END"
+	// Deprecated: only occurred in retired game modes
+	MatchEventTypeAscendedEvent MatchEventType = "ASCENDED_EVENT"
+	// Deprecated: only occurred in retired game modes
+	MatchEventTypeCapturePoint MatchEventType = "CAPTURE_POINT"
+	// Deprecated: only occurred in retired game modes
+	MatchEventTypePoroKingSummon MatchEventType = "PORO_KING_SUMMON"
 )
 
 var (
 	// MatchEventTypes is a list of all available match events
 	MatchEventTypes = []MatchEventType{
 		MatchEventTypeChampionKill,
+		MatchEventTypeChampionSpecialKill,
+		MatchEventTypeChampionTransform,
 		MatchEventTypeWardPlaced,
 		MatchEventTypeWardKill,
 		MatchEventTypeBuildingKill,
+		MatchEventTypeTurretPlateDestroyed,
 		MatchEventTypeEliteMonsterKill,
+		MatchEventTypeDragonSoulGiven,
+		MatchEventTypeObjectiveBountyPrestart,
+		MatchEventTypeObjectiveBountyFinish,
 		MatchEventTypeItemPurchased,
 		MatchEventTypeItemSold,
 		MatchEventTypeItemDestroyed,
 		MatchEventTypeItemUndo,
 		MatchEventTypeSkillLevelUp,
+		MatchEventTypeLevelUp,
+		MatchEventTypePauseEnd,
+		MatchEventTypeFeatUpdate,
+		MatchEventTypeGameEnd,
 		MatchEventTypeAscendedEvent,
 		MatchEventTypeCapturePoint,
 		MatchEventTypePoroKingSummon,
 	}
 )
 
-// MatchEvent is an event in a match at a certain timestamp
-type MatchEvent struct {
-	EventType               string          `json:"eventType"`
-	TowerType               string          `json:"towerType"`
-	TeamID                  int             `json:"teamId"`
-	AscendedType            string          `json:"ascendedType"`
-	KillerID                int             `json:"killerId"`
-	LevelUpType             string          `json:"levelUpType"`
-	PointCaptured           string          `json:"pointCaptured"`
-	AssistingParticipantIDs []int           `json:"assistingParticipantIds"`
-	WardType                string          `json:"wardType"`
-	MonsterType             string          `json:"monsterType"`
-	Type                    *MatchEventType `json:"type"`
-	SkillSlot               int             `json:"skillSlot"`
-	VictimID                int             `json:"victimId"`
-	Timestamp               int             `json:"timestamp"`
-	AfterID                 int             `json:"afterId"`
-	MonsterSubType          string          `json:"monsterSubType"`
-	LaneType                string          `json:"laneType"`
-	ItemID                  int             `json:"itemId"`
-	ParticipantID           int             `json:"participantId"`
-	BuildingType            string          `json:"buildingType"`
-	CreatorID               int             `json:"creatorId"`
-	Position                *MatchPosition  `json:"position"`
-	BeforeID                int             `json:"beforeId"`
+// MatchEvent is an event in the timeline of a match. Every event type has its own implementation, e.g. events of
+// type MatchEventTypeChampionKill are decoded into a *ChampionKillEvent. Events of unknown types are decoded into
+// an *UnknownEvent.
+type MatchEvent interface {
+	// EventType returns the type of the event
+	EventType() MatchEventType
+	// EventTimestamp returns the time of the event in milliseconds since the start of the game
+	EventTimestamp() int
+}
+
+// MatchEventHeader contains the fields common to all events
+type MatchEventHeader struct {
+	Type      MatchEventType `json:"type"`
+	Timestamp int            `json:"timestamp"`
+}
+
+// EventType returns the type of the event
+func (h MatchEventHeader) EventType() MatchEventType {
+	return h.Type
+}
+
+// EventTimestamp returns the time of the event in milliseconds since the start of the game
+func (h MatchEventHeader) EventTimestamp() int {
+	return h.Timestamp
+}
+
+// UnmarshalMatchEvent decodes an event into the type matching its event type
+func UnmarshalMatchEvent(data []byte) (MatchEvent, error) {
+	var header MatchEventHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	newEvent, ok := matchEventConstructors[header.Type]
+	if !ok {
+		return &UnknownEvent{
+			MatchEventHeader: header,
+			Data:             append(json.RawMessage(nil), data...),
+		}, nil
+	}
+	event := newEvent()
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+var matchEventConstructors = map[MatchEventType]func() MatchEvent{
+	MatchEventTypeChampionKill:            func() MatchEvent { return &ChampionKillEvent{} },
+	MatchEventTypeChampionSpecialKill:     func() MatchEvent { return &ChampionSpecialKillEvent{} },
+	MatchEventTypeChampionTransform:       func() MatchEvent { return &ChampionTransformEvent{} },
+	MatchEventTypeWardPlaced:              func() MatchEvent { return &WardPlacedEvent{} },
+	MatchEventTypeWardKill:                func() MatchEvent { return &WardKillEvent{} },
+	MatchEventTypeBuildingKill:            func() MatchEvent { return &BuildingKillEvent{} },
+	MatchEventTypeTurretPlateDestroyed:    func() MatchEvent { return &TurretPlateDestroyedEvent{} },
+	MatchEventTypeEliteMonsterKill:        func() MatchEvent { return &EliteMonsterKillEvent{} },
+	MatchEventTypeDragonSoulGiven:         func() MatchEvent { return &DragonSoulGivenEvent{} },
+	MatchEventTypeObjectiveBountyPrestart: func() MatchEvent { return &ObjectiveBountyPrestartEvent{} },
+	MatchEventTypeObjectiveBountyFinish:   func() MatchEvent { return &ObjectiveBountyFinishEvent{} },
+	MatchEventTypeItemPurchased:           func() MatchEvent { return &ItemPurchasedEvent{} },
+	MatchEventTypeItemSold:                func() MatchEvent { return &ItemSoldEvent{} },
+	MatchEventTypeItemDestroyed:           func() MatchEvent { return &ItemDestroyedEvent{} },
+	MatchEventTypeItemUndo:                func() MatchEvent { return &ItemUndoEvent{} },
+	MatchEventTypeSkillLevelUp:            func() MatchEvent { return &SkillLevelUpEvent{} },
+	MatchEventTypeLevelUp:                 func() MatchEvent { return &LevelUpEvent{} },
+	MatchEventTypePauseEnd:                func() MatchEvent { return &PauseEndEvent{} },
+	MatchEventTypeFeatUpdate:              func() MatchEvent { return &FeatUpdateEvent{} },
+	MatchEventTypeGameEnd:                 func() MatchEvent { return &GameEndEvent{} },
+}
+
+// MatchEventVisitor calls the handler matching the type of an event. Events without a handler are passed to
+// Default if it is set and are skipped otherwise.
+type MatchEventVisitor struct {
+	ChampionKill            func(event *ChampionKillEvent)
+	ChampionSpecialKill     func(event *ChampionSpecialKillEvent)
+	ChampionTransform       func(event *ChampionTransformEvent)
+	WardPlaced              func(event *WardPlacedEvent)
+	WardKill                func(event *WardKillEvent)
+	BuildingKill            func(event *BuildingKillEvent)
+	TurretPlateDestroyed    func(event *TurretPlateDestroyedEvent)
+	EliteMonsterKill        func(event *EliteMonsterKillEvent)
+	DragonSoulGiven         func(event *DragonSoulGivenEvent)
+	ObjectiveBountyPrestart func(event *ObjectiveBountyPrestartEvent)
+	ObjectiveBountyFinish   func(event *ObjectiveBountyFinishEvent)
+	ItemPurchased           func(event *ItemPurchasedEvent)
+	ItemSold                func(event *ItemSoldEvent)
+	ItemDestroyed           func(event *ItemDestroyedEvent)
+	ItemUndo                func(event *ItemUndoEvent)
+	SkillLevelUp            func(event *SkillLevelUpEvent)
+	LevelUp                 func(event *LevelUpEvent)
+	PauseEnd                func(event *PauseEndEvent)
+	FeatUpdate              func(event *FeatUpdateEvent)
+	GameEnd                 func(event *GameEndEvent)
+	Default                 func(event MatchEvent)
+}
+
+// Visit passes the event to the handler matching its type
+func (v *MatchEventVisitor) Visit(event MatchEvent) {
+	if e, ok := event.(visitable); ok && e.accept(v) {
+		return
+	}
+	if v.Default != nil {
+		v.Default(event)
+	}
+}
+
+// visitable is implemented by all events with a handler in MatchEventVisitor
+type visitable interface {
+	accept(v *MatchEventVisitor) bool
+}
+
+func (e *ChampionKillEvent) accept(v *MatchEventVisitor) bool { return call(v.ChampionKill, e) }
+func (e *ChampionSpecialKillEvent) accept(v *MatchEventVisitor) bool {
+	return call(v.ChampionSpecialKill, e)
+}
+func (e *ChampionTransformEvent) accept(v *MatchEventVisitor) bool {
+	return call(v.ChampionTransform, e)
+}
+func (e *WardPlacedEvent) accept(v *MatchEventVisitor) bool   { return call(v.WardPlaced, e) }
+func (e *WardKillEvent) accept(v *MatchEventVisitor) bool     { return call(v.WardKill, e) }
+func (e *BuildingKillEvent) accept(v *MatchEventVisitor) bool { return call(v.BuildingKill, e) }
+func (e *TurretPlateDestroyedEvent) accept(v *MatchEventVisitor) bool {
+	return call(v.TurretPlateDestroyed, e)
+}
+func (e *EliteMonsterKillEvent) accept(v *MatchEventVisitor) bool { return call(v.EliteMonsterKill, e) }
+func (e *DragonSoulGivenEvent) accept(v *MatchEventVisitor) bool  { return call(v.DragonSoulGiven, e) }
+func (e *ObjectiveBountyPrestartEvent) accept(v *MatchEventVisitor) bool {
+	return call(v.ObjectiveBountyPrestart, e)
+}
+func (e *ObjectiveBountyFinishEvent) accept(v *MatchEventVisitor) bool {
+	return call(v.ObjectiveBountyFinish, e)
+}
+func (e *ItemPurchasedEvent) accept(v *MatchEventVisitor) bool { return call(v.ItemPurchased, e) }
+func (e *ItemSoldEvent) accept(v *MatchEventVisitor) bool      { return call(v.ItemSold, e) }
+func (e *ItemDestroyedEvent) accept(v *MatchEventVisitor) bool { return call(v.ItemDestroyed, e) }
+func (e *ItemUndoEvent) accept(v *MatchEventVisitor) bool      { return call(v.ItemUndo, e) }
+func (e *SkillLevelUpEvent) accept(v *MatchEventVisitor) bool  { return call(v.SkillLevelUp, e) }
+func (e *LevelUpEvent) accept(v *MatchEventVisitor) bool       { return call(v.LevelUp, e) }
+func (e *PauseEndEvent) accept(v *MatchEventVisitor) bool      { return call(v.PauseEnd, e) }
+func (e *FeatUpdateEvent) accept(v *MatchEventVisitor) bool    { return call(v.FeatUpdate, e) }
+func (e *GameEndEvent) accept(v *MatchEventVisitor) bool       { return call(v.GameEnd, e) }
+
+// call calls the handler with the event if the handler is set
+func call[T MatchEvent](handler func(T), event T) bool {
+	if handler == nil {
+		return false
+	}
+	handler(event)
+	return true
+}
+
+// ChampionKillEvent is emitted when a champion is killed
+type ChampionKillEvent struct {
+	MatchEventHeader
+	AssistingParticipantIDs []int             `json:"assistingParticipantIds"`
+	Bounty                  int               `json:"bounty"`
+	KillStreakLength        int               `json:"killStreakLength"`
+	KillerID                int               `json:"killerId"`
+	Position                *MatchPosition    `json:"position"`
+	ShutdownBounty          int               `json:"shutdownBounty"`
+	VictimDamageDealt       []*DamageInstance `json:"victimDamageDealt"`
+	VictimDamageReceived    []*DamageInstance `json:"victimDamageReceived"`
+	VictimID                int               `json:"victimId"`
+}
+
+// DamageInstance is damage dealt or received by the victim of a champion kill
+type DamageInstance struct {
+	Basic          bool   `json:"basic"`
+	MagicDamage    int    `json:"magicDamage"`
+	Name           string `json:"name"`
+	ParticipantID  int    `json:"participantId"`
+	PhysicalDamage int    `json:"physicalDamage"`
+	SpellName      string `json:"spellName"`
+	SpellSlot      int    `json:"spellSlot"`
+	TrueDamage     int    `json:"trueDamage"`
+	Type           string `json:"type"`
+}
+
+// ChampionSpecialKillEvent is emitted for first bloods, multi kills and aces
+type ChampionSpecialKillEvent struct {
+	MatchEventHeader
+	KillType        string         `json:"killType"`
+	KillerID        int            `json:"killerId"`
+	MultiKillLength int            `json:"multiKillLength"`
+	Position        *MatchPosition `json:"position"`
+}
+
+// ChampionTransformEvent is emitted when a champion transforms, e.g. Kayn choosing a form
+type ChampionTransformEvent struct {
+	MatchEventHeader
+	ParticipantID int    `json:"participantId"`
+	TransformType string `json:"transformType"`
+}
+
+// WardPlacedEvent is emitted when a ward is placed
+type WardPlacedEvent struct {
+	MatchEventHeader
+	CreatorID int    `json:"creatorId"`
+	WardType  string `json:"wardType"`
+}
+
+// WardKillEvent is emitted when a ward is destroyed
+type WardKillEvent struct {
+	MatchEventHeader
+	KillerID int    `json:"killerId"`
+	WardType string `json:"wardType"`
+}
+
+// BuildingKillEvent is emitted when a turret or inhibitor is destroyed
+type BuildingKillEvent struct {
+	MatchEventHeader
+	AssistingParticipantIDs []int          `json:"assistingParticipantIds"`
+	Bounty                  int            `json:"bounty"`
+	BuildingType            string         `json:"buildingType"`
+	KillerID                int            `json:"killerId"`
+	LaneType                string         `json:"laneType"`
+	Position                *MatchPosition `json:"position"`
+	TeamID                  int            `json:"teamId"`
+	TowerType               string         `json:"towerType"`
+}
+
+// TurretPlateDestroyedEvent is emitted when a turret plate is destroyed
+type TurretPlateDestroyedEvent struct {
+	MatchEventHeader
+	KillerID int            `json:"killerId"`
+	LaneType string         `json:"laneType"`
+	Position *MatchPosition `json:"position"`
+	TeamID   int            `json:"teamId"`
+}
+
+// EliteMonsterKillEvent is emitted when an epic monster like a dragon or baron is killed
+type EliteMonsterKillEvent struct {
+	MatchEventHeader
+	AssistingParticipantIDs []int          `json:"assistingParticipantIds"`
+	Bounty                  int            `json:"bounty"`
+	KillerID                int            `json:"killerId"`
+	KillerTeamID            int            `json:"killerTeamId"`
+	MonsterSubType          string         `json:"monsterSubType"`
+	MonsterType             string         `json:"monsterType"`
+	Position                *MatchPosition `json:"position"`
+}
+
+// DragonSoulGivenEvent is emitted when a team obtains the dragon soul
+type DragonSoulGivenEvent struct {
+	MatchEventHeader
+	Name   string `json:"name"`
+	TeamID int    `json:"teamId"`
+}
+
+// ObjectiveBountyPrestartEvent announces the objective bounty of a team
+type ObjectiveBountyPrestartEvent struct {
+	MatchEventHeader
+	ActualStartTime int `json:"actualStartTime"`
+	TeamID          int `json:"teamId"`
+}
+
+// ObjectiveBountyFinishEvent is emitted when the objective bounty of a team ends
+type ObjectiveBountyFinishEvent struct {
+	MatchEventHeader
+	TeamID int `json:"teamId"`
+}
+
+// ItemPurchasedEvent is emitted when an item is bought
+type ItemPurchasedEvent struct {
+	MatchEventHeader
+	ItemID        int `json:"itemId"`
+	ParticipantID int `json:"participantId"`
+}
+
+// GetItem returns the item for this event
+func (e *ItemPurchasedEvent) GetItem(client *datadragon.Client) (datadragon.Item, error) {
+	return client.GetItem(strconv.Itoa(e.ItemID))
+}
+
+// ItemSoldEvent is emitted when an item is sold
+type ItemSoldEvent struct {
+	MatchEventHeader
+	ItemID        int `json:"itemId"`
+	ParticipantID int `json:"participantId"`
+}
+
+// GetItem returns the item for this event
+func (e *ItemSoldEvent) GetItem(client *datadragon.Client) (datadragon.Item, error) {
+	return client.GetItem(strconv.Itoa(e.ItemID))
+}
+
+// ItemDestroyedEvent is emitted when an item is consumed or transformed
+type ItemDestroyedEvent struct {
+	MatchEventHeader
+	ItemID        int `json:"itemId"`
+	ParticipantID int `json:"participantId"`
 }
 
 // GetItem returns the item for this event
-func (e *MatchEvent) GetItem(client *datadragon.Client) (datadragon.Item, error) {
+func (e *ItemDestroyedEvent) GetItem(client *datadragon.Client) (datadragon.Item, error) {
 	return client.GetItem(strconv.Itoa(e.ItemID))
 }
 
+// ItemUndoEvent is emitted when a purchase or sale is undone
+type ItemUndoEvent struct {
+	MatchEventHeader
+	AfterID       int `json:"afterId"`
+	BeforeID      int `json:"beforeId"`
+	GoldGain      int `json:"goldGain"`
+	ParticipantID int `json:"participantId"`
+}
+
+// SkillLevelUpEvent is emitted when a skill is leveled up
+type SkillLevelUpEvent struct {
+	MatchEventHeader
+	LevelUpType   string `json:"levelUpType"`
+	ParticipantID int    `json:"participantId"`
+	SkillSlot     int    `json:"skillSlot"`
+}
+
+// LevelUpEvent is emitted when a champion levels up
+type LevelUpEvent struct {
+	MatchEventHeader
+	Level         int `json:"level"`
+	ParticipantID int `json:"participantId"`
+}
+
+// PauseEndEvent is emitted when the game starts or resumes after a pause
+type PauseEndEvent struct {
+	MatchEventHeader
+	RealTimestamp int64 `json:"realTimestamp"`
+}
+
+// FeatUpdateEvent is emitted when a team progresses a feat of strength
+type FeatUpdateEvent struct {
+	MatchEventHeader
+	FeatType  int `json:"featType"`
+	FeatValue int `json:"featValue"`
+	TeamID    int `json:"teamId"`
+}
+
+// GameEndEvent is emitted when the game ends
+type GameEndEvent struct {
+	MatchEventHeader
+	GameID        int   `json:"gameId"`
+	RealTimestamp int64 `json:"realTimestamp"`
+	WinningTeam   int   `json:"winningTeam"`
+}
+
+// UnknownEvent is an event of a type without its own implementation
+type UnknownEvent struct {
+	MatchEventHeader
+	// Data is the raw event
+	Data json.RawMessage `json:"-"`
+}
+
+// MarshalJSON returns the raw event
+func (e *UnknownEvent) MarshalJSON() ([]byte, error) {
+	if e.Data != nil {
+		return e.Data, nil
+	}
+	return json.Marshal(e.MatchEventHeader)
+}
+
 // MatchPosition is a position on the map in a game
 type MatchPosition struct {
 	X int `json:"x"`
@@ -570,9 +1370,28 @@ type GameInfo struct {
 	GameQueueConfigID int                       `json:"gameQueueConfigId"`
 }
 
-// GetMatch returns information about the finished match
+// GetMatch returns information about the finished match. The match is requested from the platform the game is
+// played on, falling back to the region of the client if the platform is unknown.
 func (i *GameInfo) GetMatch(client *Client) (*Match, error) {
-	return client.Match.Get(fmt.Sprintf("%v_%v", strings.ToUpper(string(client.Match.c.Region)), i.GameID))
+	return i.GetMatchWithContext(context.Background(), client)
+}
+
+// GetMatchWithContext is like GetMatch but binds the request to the given context
+func (i *GameInfo) GetMatchWithContext(ctx context.Context, client *Client) (*Match, error) {
+	if i.PlatformID != "" && client.base != nil {
+		client = client.ForRegion(api.Region(strings.ToLower(i.PlatformID)))
+	}
+	return client.Match.GetWithContext(ctx, i.MatchID(client.Match.c.Region))
+}
+
+// MatchID returns the ID of the match of this game. The platform the game is played on is preferred over the
+// given region.
+func (i *GameInfo) MatchID(region api.Region) string {
+	platform := i.PlatformID
+	if platform == "" {
+		platform = string(region)
+	}
+	return fmt.Sprintf("%v_%v", strings.ToUpper(platform), i.GameID)
 }
 
 // BannedChampion represents a champion ban during pack/ban phase
@@ -594,8 +1413,12 @@ type Observer struct {
 
 // CurrentGameParticipant represents a player in an ongoing game
 type CurrentGameParticipant struct {
-	ProfileIconID            int                        `json:"profileIconId"`
-	ChampionID               int                        `json:"championId"`
+	ProfileIconID int    `json:"profileIconId"`
+	ChampionID    int    `json:"championId"`
+	PUUID         string `json:"puuid"`
+	// RiotID is the game name and tag line separated by #
+	RiotID string `json:"riotId"`
+	// Deprecated: not returned by spectator-v5, use RiotID instead
 	SummonerName             string                     `json:"summonerName"`
 	GameCustomizationObjects []*GameCustomizationObject `json:"gameCustomizationObjects"`
 	Bot                      bool                       `json:"bot"`
@@ -686,7 +1509,8 @@ type StatusTranslation struct {
 
 // Summoner represents a summoner with several related IDs
 type Summoner struct {
-	ProfileIconID int    `json:"profileIconId"`
+	ProfileIconID int `json:"profileIconId"`
+	// Deprecated: Riot has removed summoner names in favor of Riot IDs, see account.Account
 	Name          string `json:"name"`
 	PUUID         string `json:"puuid"`
 	SummonerLevel int    `json:"summonerLevel"`
@@ -1,7 +1,12 @@
 package lol
 
 import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -49,6 +54,16 @@ func TestLeagueList_GetRank(t *testing.T) {
 	}
 }
 
+func TestLeagueList_Items(t *testing.T) {
+	entries := []*LeagueItem{{PUUID: "a", LeaguePoints: 10}, {PUUID: "b", LeaguePoints: 20}}
+	l := &LeagueList{LeagueID: "league", Tier: "MASTER", Queue: "RANKED_SOLO_5x5", Entries: entries}
+	require.Equal(t, []*LeagueItem{
+		{LeagueID: "league", PUUID: "b", QueueType: "RANKED_SOLO_5x5", Tier: "MASTER", LeaguePoints: 20},
+		{LeagueID: "league", PUUID: "a", QueueType: "RANKED_SOLO_5x5", Tier: "MASTER", LeaguePoints: 10},
+	}, l.Items())
+	require.Empty(t, entries[0].LeagueID)
+}
+
 func TestChampionInfo_GetChampionsForNewPlayers(t *testing.T) {
 	type test struct {
 		name    string
@@ -91,7 +106,7 @@ func TestChampionInfo_GetChampionsForNewPlayers(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetChampionsForNewPlayers(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -140,7 +155,7 @@ func TestChampionInfo_GetChampions(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetChampions(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -168,7 +183,7 @@ func TestChampionMastery_GetSummoner(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionKorea, "key", test.doer, log.StandardLogger())
 				got, err := test.model.GetSummoner(NewClient(client))
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -200,7 +215,7 @@ func TestChampionMastery_GetChampion(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetChampion(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -228,13 +243,74 @@ func TestLeagueItem_GetSummoner(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionKorea, "key", test.doer, log.StandardLogger())
 				got, err := test.model.GetSummoner(NewClient(client))
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
 	}
 }
 
+func TestMatchInfo_Duration(t *testing.T) {
+	tests := []struct {
+		name  string
+		model MatchInfo
+		want  time.Duration
+	}{
+		{
+			name:  "milliseconds before patch 11.20",
+			model: MatchInfo{GameDuration: 1830500},
+			want:  1830500 * time.Millisecond,
+		},
+		{
+			name:  "seconds after patch 11.20",
+			model: MatchInfo{GameDuration: 1830, GameEndTimestamp: 1700000000000},
+			want:  1830 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				assert.Equal(t, test.want, test.model.Duration())
+			},
+		)
+	}
+}
+
+func TestMatchInfo_Subteams(t *testing.T) {
+	p1 := &Participant{PUUID: "1", PlayerSubteamID: 3, SubteamPlacement: 2}
+	p2 := &Participant{PUUID: "2", PlayerSubteamID: 1, SubteamPlacement: 1}
+	p3 := &Participant{PUUID: "3", PlayerSubteamID: 3, SubteamPlacement: 2}
+	p4 := &Participant{PUUID: "4", PlayerSubteamID: 2}
+	p5 := &Participant{PUUID: "5", PlayerSubteamID: 1, SubteamPlacement: 1}
+	tests := []struct {
+		name  string
+		model MatchInfo
+		want  []*Subteam
+	}{
+		{
+			name:  "arena",
+			model: MatchInfo{GameMode: GameModeArena, Participants: []*Participant{p1, p2, p3, p4, p5}},
+			want: []*Subteam{
+				{ID: 1, Placement: 1, Participants: []*Participant{p2, p5}},
+				{ID: 3, Placement: 2, Participants: []*Participant{p1, p3}},
+				{ID: 2, Participants: []*Participant{p4}},
+			},
+		},
+		{
+			name:  "summoner's rift",
+			model: MatchInfo{GameMode: "CLASSIC", Participants: []*Participant{{TeamID: 100}, {TeamID: 200}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				assert.Equal(t, test.model.GameMode == GameModeArena, test.model.IsArena())
+				assert.Equal(t, test.want, test.model.Subteams())
+			},
+		)
+	}
+}
+
 func TestMatchInfo_GetQueue(t *testing.T) {
 	type test struct {
 		name    string
@@ -256,7 +332,7 @@ func TestMatchInfo_GetQueue(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := static.NewClient(test.doer, log.StandardLogger())
 				got, err := test.model.GetQueue(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -284,7 +360,7 @@ func TestMatchInfo_GetMap(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := static.NewClient(test.doer, log.StandardLogger())
 				got, err := test.model.GetMap(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -312,7 +388,7 @@ func TestMatchInfo_GetGameType(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := static.NewClient(test.doer, log.StandardLogger())
 				got, err := test.model.GetGameType(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -340,7 +416,7 @@ func TestMatchInfo_GetGameMode(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := static.NewClient(test.doer, log.StandardLogger())
 				got, err := test.model.GetGameMode(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -368,7 +444,7 @@ func TestParticipant_GetSummoner(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionKorea, "key", test.doer, log.StandardLogger())
 				got, err := test.model.GetSummoner(NewClient(client))
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -400,7 +476,46 @@ func TestParticipant_GetProfileIcon(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetProfileIcon(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
+				assert.Equal(t, test.want, got)
+			},
+		)
+	}
+}
+
+func TestParticipant_GetAugments(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		model   Participant
+		want    []static.Augment
+		wantErr error
+	}
+	tests := []test{
+		{
+			name:  "valid",
+			doer:  mock.NewJSONMockDoer([]static.Augment{{ID: 1}, {ID: 2}, {ID: 3}}, 200),
+			model: Participant{PlayerAugment1: 3, PlayerAugment2: 1},
+			want:  []static.Augment{{ID: 3}, {ID: 1}},
+		},
+		{
+			name:  "no augments",
+			doer:  mock.NewStatusMockDoer(http.StatusNotFound),
+			model: Participant{},
+		},
+		{
+			name:    "unknown augment",
+			doer:    mock.NewJSONMockDoer([]static.Augment{{ID: 1}}, 200),
+			model:   Participant{PlayerAugment1: 1, PlayerAugment4: 5},
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				client := static.NewClient(test.doer, log.StandardLogger())
+				got, err := test.model.GetAugments(client)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -432,7 +547,7 @@ func TestTeamBan_GetChampion(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetChampion(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -464,7 +579,7 @@ func TestParticipant_GetChampion(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetChampion(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -496,7 +611,7 @@ func TestParticipant_GetSpell1(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetSpell1(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -528,7 +643,7 @@ func TestParticipant_GetSpell2(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetSpell2(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -560,7 +675,7 @@ func TestParticipant_GetItem0(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetItem0(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -592,7 +707,7 @@ func TestParticipant_GetItem1(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetItem1(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -624,7 +739,7 @@ func TestParticipant_GetItem2(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetItem2(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -656,7 +771,7 @@ func TestParticipant_GetItem3(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetItem3(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -688,7 +803,7 @@ func TestParticipant_GetItem4(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetItem4(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -720,7 +835,7 @@ func TestParticipant_GetItem5(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetItem5(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -752,7 +867,7 @@ func TestParticipant_GetItem6(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetItem6(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -784,7 +899,7 @@ func TestBannedChampion_GetChampion(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetChampion(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -816,7 +931,7 @@ func TestCurrentGameParticipant_GetChampion(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetChampion(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -848,7 +963,7 @@ func TestCurrentGameParticipant_GetSpell1(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetSpell1(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -880,7 +995,7 @@ func TestCurrentGameParticipant_GetSpell2(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetSpell2(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
@@ -902,24 +1017,42 @@ func TestGameInfo_GetMatch(t *testing.T) {
 			model: GameInfo{GameID: 1},
 			want:  &Match{Metadata: &MatchMetadata{MatchID: "NA1_1"}},
 		},
+		{
+			name: "platform of the game",
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if r.URL.Host != "europe.api.riotgames.com" || r.URL.Path != "/lol/match/v5/matches/EUW1_1" {
+						return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+					}
+					return mock.NewJSONMockDoer(Match{Metadata: &MatchMetadata{MatchID: "EUW1_1"}}, 200).Do(r)
+				},
+			},
+			model: GameInfo{GameID: 1, PlatformID: "EUW1"},
+			want:  &Match{Metadata: &MatchMetadata{MatchID: "EUW1_1"}},
+		},
 	}
 	for _, test := range tests {
 		t.Run(
 			test.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionKorea, "key", test.doer, log.StandardLogger())
 				got, err := test.model.GetMatch(NewClient(client))
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
 	}
 }
 
-func TestMatchEvent_GetItem(t *testing.T) {
+func TestGameInfo_MatchID(t *testing.T) {
+	assert.Equal(t, "KR_1", (&GameInfo{GameID: 1}).MatchID(api.RegionKorea))
+	assert.Equal(t, "EUW1_1", (&GameInfo{GameID: 1, PlatformID: "EUW1"}).MatchID(api.RegionKorea))
+}
+
+func TestItemPurchasedEvent_GetItem(t *testing.T) {
 	type test struct {
 		name    string
 		doer    internal.Doer
-		model   MatchEvent
+		model   ItemPurchasedEvent
 		want    datadragon.Item
 		wantErr error
 	}
@@ -931,7 +1064,7 @@ func TestMatchEvent_GetItem(t *testing.T) {
 					"1": {},
 				},
 			),
-			model: MatchEvent{ItemID: 1},
+			model: ItemPurchasedEvent{ItemID: 1},
 			want:  datadragon.Item{ID: "1"},
 		},
 	}
@@ -940,13 +1073,153 @@ func TestMatchEvent_GetItem(t *testing.T) {
 			test.name, func(t *testing.T) {
 				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
 				got, err := test.model.GetItem(client)
-				assert.Equal(t, test.wantErr, err)
+				assert.ErrorIs(t, err, test.wantErr)
 				assert.Equal(t, test.want, got)
 			},
 		)
 	}
 }
 
+func TestMatch_UnmarshalJSON(t *testing.T) {
+	data, err := os.ReadFile("testdata/match.json")
+	require.Nil(t, err)
+	var match Match
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// fail on fields of the response which are not modelled
+	decoder.DisallowUnknownFields()
+	require.Nil(t, decoder.Decode(&match))
+	assert.Equal(t, "EUW1_7012345678", match.Metadata.MatchID)
+	assert.Len(t, match.Metadata.Participants, 10)
+	assert.Equal(t, "GameComplete", match.Info.EndOfGameResult)
+	require.Len(t, match.Info.Participants, 10)
+	participant := match.Info.Participants[0]
+	assert.Equal(t, "Garen", participant.ChampionName)
+	assert.Equal(t, "TOP", participant.TeamPosition)
+	assert.True(t, participant.EligibleForProgression)
+	assert.Empty(t, participant.Augments())
+	assert.InDelta(t, 2.75, participant.Challenges.KDA, 1e-9)
+	assert.Equal(t, 11, participant.Challenges.Takedowns)
+	assert.Equal(t, []int{3078, 6610, 3065}, participant.Challenges.LegendaryItemUsed)
+	assert.Zero(t, participant.Missions.PlayerScore3)
+	assert.Equal(t, "Lowland Bell", match.Info.Participants[9].RiotIDGameName)
+	require.Len(t, match.Info.Teams, 2)
+	assert.Equal(t, Feat{FeatState: 1001}, match.Info.Teams[0].Feats.FirstBlood)
+	assert.True(t, match.Info.Teams[0].Objectives.Horde.First)
+	assert.True(t, match.Info.Teams[0].Objectives.Atakhan.First)
+	assert.Equal(t, 15, match.Info.Teams[1].Objectives.Champion.Kills)
+}
+
+func TestMatchTimeline_UnmarshalJSON(t *testing.T) {
+	timeline := *loadTimeline(t)
+	assert.Equal(t, "EUW1_7000000000", timeline.Metadata.MatchID)
+	assert.Equal(t, 60000, timeline.Info.FrameInterval)
+	assert.Equal(t, &TimelineParticipant{ParticipantID: 2, PUUID: "puuid-2"}, timeline.Info.Participants[1])
+	require.Len(t, timeline.Info.Frames, 2)
+	frame := timeline.Info.Frames[1].ParticipantFrames["1"]
+	assert.Equal(t, 720, frame.ChampionStats.HealthMax)
+	assert.Equal(t, 180, frame.DamageStats.TotalDamageDoneToChampions)
+	assert.Equal(t, &MatchPosition{X: 5846, Y: 6396}, frame.Position)
+	events := timeline.Info.Frames[1].Events
+	types := make([]MatchEventType, 0, len(events))
+	for _, event := range events {
+		types = append(types, event.EventType())
+	}
+	assert.Equal(
+		t, []MatchEventType{
+			MatchEventTypeItemPurchased, MatchEventTypeSkillLevelUp, MatchEventTypeWardPlaced, MatchEventTypeWardKill,
+			MatchEventTypeChampionKill, MatchEventTypeChampionSpecialKill, MatchEventTypeTurretPlateDestroyed,
+			MatchEventTypeBuildingKill, MatchEventTypeEliteMonsterKill, MatchEventTypeDragonSoulGiven,
+			MatchEventTypeFeatUpdate, "SOMETHING_NEW", MatchEventTypeGameEnd,
+		}, types,
+	)
+	assert.Equal(
+		t, &ItemPurchasedEvent{
+			MatchEventHeader: MatchEventHeader{Type: MatchEventTypeItemPurchased, Timestamp: 1200},
+			ItemID:           1055,
+			ParticipantID:    1,
+		}, events[0],
+	)
+	kill, ok := events[4].(*ChampionKillEvent)
+	require.True(t, ok)
+	assert.Equal(t, 6, kill.VictimID)
+	assert.Equal(t, []int{3}, kill.AssistingParticipantIDs)
+	assert.Equal(t, 200, kill.VictimDamageReceived[0].MagicDamage)
+	assert.Equal(t, "ashebasicattack", kill.VictimDamageDealt[0].SpellName)
+	unknown, ok := events[11].(*UnknownEvent)
+	require.True(t, ok)
+	assert.Equal(t, 45000, unknown.EventTimestamp())
+	assert.JSONEq(t, `{"timestamp": 45000, "type": "SOMETHING_NEW", "value": 1}`, string(unknown.Data))
+	assert.Equal(t, 100, events[12].(*GameEndEvent).WinningTeam)
+	assert.Equal(t, int64(1700000000000), timeline.Info.Frames[0].Events[0].(*PauseEndEvent).RealTimestamp)
+
+	encoded, err := json.Marshal(timeline)
+	require.Nil(t, err)
+	var decoded MatchTimeline
+	require.Nil(t, json.Unmarshal(encoded, &decoded))
+	reencoded, err := json.Marshal(decoded)
+	require.Nil(t, err)
+	assert.JSONEq(t, string(encoded), string(reencoded))
+	assert.Equal(t, timeline.Info.Frames[1].Events[4], decoded.Info.Frames[1].Events[4])
+}
+
+func loadTimeline(t *testing.T) *MatchTimeline {
+	data, err := os.ReadFile("testdata/timeline.json")
+	require.Nil(t, err)
+	var timeline MatchTimeline
+	require.Nil(t, json.Unmarshal(data, &timeline))
+	return &timeline
+}
+
+func TestMatchTimeline_EventsOfType(t *testing.T) {
+	timeline := loadTimeline(t)
+	assert.Len(t, timeline.Events(), 14)
+	events := timeline.EventsOfType(MatchEventTypeWardPlaced, MatchEventTypeWardKill)
+	require.Len(t, events, 2)
+	assert.Equal(t, MatchEventTypeWardPlaced, events[0].EventType())
+	assert.Equal(t, MatchEventTypeWardKill, events[1].EventType())
+	assert.Empty(t, (&MatchTimeline{}).Events())
+}
+
+func TestFilterEvents(t *testing.T) {
+	timeline := loadTimeline(t)
+	kills := FilterEvents[*ChampionKillEvent](timeline)
+	require.Len(t, kills, 1)
+	assert.Equal(t, 6, kills[0].VictimID)
+	assert.Len(t, FilterEvents[*ItemSoldEvent](timeline), 0)
+}
+
+func TestMatchTimeline_Visit(t *testing.T) {
+	timeline := loadTimeline(t)
+	var kills, wards int
+	var other []MatchEventType
+	timeline.Visit(
+		&MatchEventVisitor{
+			ChampionKill: func(event *ChampionKillEvent) {
+				kills++
+			},
+			WardPlaced: func(event *WardPlacedEvent) {
+				wards++
+			},
+			WardKill: func(event *WardKillEvent) {
+				wards++
+			},
+			Default: func(event MatchEvent) {
+				other = append(other, event.EventType())
+			},
+		},
+	)
+	assert.Equal(t, 1, kills)
+	assert.Equal(t, 2, wards)
+	assert.Equal(
+		t, []MatchEventType{
+			MatchEventTypePauseEnd, MatchEventTypeItemPurchased, MatchEventTypeSkillLevelUp,
+			MatchEventTypeChampionSpecialKill, MatchEventTypeTurretPlateDestroyed, MatchEventTypeBuildingKill,
+			MatchEventTypeEliteMonsterKill, MatchEventTypeDragonSoulGiven, MatchEventTypeFeatUpdate, "SOMETHING_NEW",
+			MatchEventTypeGameEnd,
+		}, other,
+	)
+}
+
 type dataDragonResponse struct {
 	Type    string
 	Format  string
@@ -1,11 +1,11 @@
 package lol
 
 import (
+	"context"
 	"fmt"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // SpectatorClient provides methods for the spectator endpoints of the League of Legends API.
@@ -13,11 +13,39 @@ type SpectatorClient struct {
 	c *internal.Client
 }
 
+// GetCurrentByPUUID returns a currently running game for a player
+func (s *SpectatorClient) GetCurrentByPUUID(puuid string) (*GameInfo, error) {
+	return s.GetCurrentByPUUIDWithContext(context.Background(), puuid)
+}
+
+// GetCurrentByPUUIDWithContext is like GetCurrentByPUUID but binds the request to the given context
+func (s *SpectatorClient) GetCurrentByPUUIDWithContext(ctx context.Context, puuid string) (*GameInfo, error) {
+	logger := s.logger().WithField("method", "GetCurrentByPUUID")
+	var games GameInfo
+	ctx = internal.WithEndpoint(ctx, endpointGetCurrentGameByPUUID)
+	if err := s.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetCurrentGameByPUUID, puuid), &games); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return &games, nil
+}
+
 // GetCurrent returns a currently running game for a summoner
+//
+// Deprecated: Riot has retired spectator-v4, use GetCurrentByPUUID instead. Will be removed in a future release.
 func (s *SpectatorClient) GetCurrent(summonerID string) (*GameInfo, error) {
+	return s.GetCurrentWithContext(context.Background(), summonerID)
+}
+
+// GetCurrentWithContext is like GetCurrent but binds the request to the given context
+//
+// Deprecated: Riot has retired spectator-v4, use GetCurrentByPUUIDWithContext instead. Will be removed in a future
+// release.
+func (s *SpectatorClient) GetCurrentWithContext(ctx context.Context, summonerID string) (*GameInfo, error) {
 	logger := s.logger().WithField("method", "GetCurrent")
 	var games GameInfo
-	if err := s.c.GetInto(fmt.Sprintf(endpointGetCurrentGame, summonerID), &games); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetCurrentGame)
+	if err := s.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetCurrentGame, summonerID), &games); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -26,9 +54,15 @@ func (s *SpectatorClient) GetCurrent(summonerID string) (*GameInfo, error) {
 
 // ListFeatured returns the currently featured games
 func (s *SpectatorClient) ListFeatured() (*FeaturedGames, error) {
+	return s.ListFeaturedWithContext(context.Background())
+}
+
+// ListFeaturedWithContext is like ListFeatured but binds the request to the given context
+func (s *SpectatorClient) ListFeaturedWithContext(ctx context.Context) (*FeaturedGames, error) {
 	logger := s.logger().WithField("method", "ListFeatured")
 	var games FeaturedGames
-	if err := s.c.GetInto(endpointGetFeaturedGames, &games); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetFeaturedGames)
+	if err := s.c.GetIntoWithContext(ctx, endpointGetFeaturedGames, &games); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
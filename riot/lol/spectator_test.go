@@ -38,7 +38,50 @@ func TestSpectatorClient_ListFeatured(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&SpectatorClient{c: client}).ListFeatured()
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestSpectatorClient_GetCurrentByPUUID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    *GameInfo
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: &GameInfo{GameID: 1, Participants: []*CurrentGameParticipant{{PUUID: "puuid", RiotID: "name#tag"}}},
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if r.URL.Path != "/lol/spectator/v5/active-games/by-summoner/puuid" {
+						return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+					}
+					return mock.NewJSONMockDoer(
+						GameInfo{GameID: 1, Participants: []*CurrentGameParticipant{{PUUID: "puuid", RiotID: "name#tag"}}},
+						200,
+					).Do(r)
+				},
+			},
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&SpectatorClient{c: client}).GetCurrentByPUUID("puuid")
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -71,7 +114,7 @@ func TestSpectatorClient_GetCurrent(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&SpectatorClient{c: client}).GetCurrent("id")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
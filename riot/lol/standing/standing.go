@@ -0,0 +1,195 @@
+// Package standing tracks the league standings of players. Successive lol.LeagueItem snapshots are kept in a
+// Store and compared to report the change in league points, promotions and demotions and the games played
+// since the last snapshot.
+package standing
+
+import (
+	"context"
+	"time"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// Snapshot is the league entry of a player in a queue at a point in time
+type Snapshot struct {
+	Entry   *lol.LeagueItem `json:"entry"`
+	TakenAt time.Time       `json:"takenAt"`
+}
+
+// Store persists the snapshots of players
+type Store interface {
+	// Latest returns the newest snapshot of the player with the given PUUID in the queue or nil if there is none
+	Latest(ctx context.Context, puuid, queue string) (*Snapshot, error)
+	// Append adds a snapshot of the player with the given PUUID in the queue
+	Append(ctx context.Context, puuid, queue string, snapshot *Snapshot) error
+}
+
+// Movement is the change of the tier or division between two snapshots
+type Movement int
+
+// All possible movements
+const (
+	MovementNone Movement = iota
+	MovementPromotion
+	MovementDemotion
+)
+
+// String returns the name of the movement
+func (m Movement) String() string {
+	switch m {
+	case MovementPromotion:
+		return "promotion"
+	case MovementDemotion:
+		return "demotion"
+	default:
+		return "none"
+	}
+}
+
+// Diff is the change of the standing of a player in a queue between two snapshots
+type Diff struct {
+	PUUID string
+	Queue string
+	// Previous is nil if the player was not tracked in the queue before or the season was reset since the
+	// previous snapshot
+	Previous *Snapshot
+	Current  *Snapshot
+	// LeaguePoints is the change in league points. Across divisions and tiers every division counts as 100
+	// league points, so a promotion from 90 LP in Gold I to 8 LP in Platinum IV is a change of 18 LP.
+	LeaguePoints int
+	// Wins and Losses are the games won and lost since the previous snapshot
+	Wins   int
+	Losses int
+	// Movement is set when the tier or division changed
+	Movement Movement
+	// TierChanged is set when the tier changed
+	TierChanged bool
+	// HotStreakChanged is set when the player started or ended a hot streak
+	HotStreakChanged bool
+}
+
+// Compare returns the diff between the previous and the current snapshot of a player. The previous snapshot may
+// be nil. If the current snapshot has fewer games than the previous one, the counters were reset by a new season
+// or split and the current snapshot is treated as the first one.
+func Compare(previous, current *Snapshot) *Diff {
+	diff := &Diff{
+		PUUID:   current.Entry.PUUID,
+		Queue:   current.Entry.QueueType,
+		Current: current,
+	}
+	if previous == nil {
+		return diff
+	}
+	prev, cur := previous.Entry, current.Entry
+	if cur.Wins+cur.Losses < prev.Wins+prev.Losses {
+		return diff
+	}
+	diff.Previous = previous
+	diff.Wins = cur.Wins - prev.Wins
+	diff.Losses = cur.Losses - prev.Losses
+	diff.TierChanged = cur.Tier != prev.Tier
+	diff.HotStreakChanged = cur.HotStreak != prev.HotStreak
+	prevTier, prevDivision, prevOK := position(prev)
+	curTier, curDivision, curOK := position(cur)
+	if !prevOK || !curOK {
+		diff.LeaguePoints = cur.LeaguePoints - prev.LeaguePoints
+		return diff
+	}
+	diff.LeaguePoints = (curDivision-prevDivision)*100 + cur.LeaguePoints - prev.LeaguePoints
+	diff.Movement = movement(prevTier, prevDivision, curTier, curDivision)
+	return diff
+}
+
+// movement compares the positions of two entries, where apex tiers are only distinguished by their tier
+func movement(prevTier, prevDivision, curTier, curDivision int) Movement {
+	switch {
+	case curDivision > prevDivision || curDivision == prevDivision && curTier > prevTier:
+		return MovementPromotion
+	case curDivision < prevDivision || curDivision == prevDivision && curTier < prevTier:
+		return MovementDemotion
+	default:
+		return MovementNone
+	}
+}
+
+// Changed returns whether anything but the time of the snapshot changed
+func (d *Diff) Changed() bool {
+	return d.Previous == nil || d.LeaguePoints != 0 || d.Wins != 0 || d.Losses != 0 ||
+		d.Movement != MovementNone || d.TierChanged || d.HotStreakChanged
+}
+
+// position returns the index of the tier of the entry in lol.Tiers and the number of divisions below its
+// division. All apex tiers share the division above Diamond I, as their league points continue from Master to
+// Challenger.
+func position(entry *lol.LeagueItem) (int, int, bool) {
+	for i, tier := range lol.Tiers {
+		if entry.Tier != string(tier) {
+			continue
+		}
+		if tier.IsApex() {
+			return i, (len(lol.Tiers) - len(lol.ApexTiers)) * len(lol.Divisions), true
+		}
+		for j, division := range lol.Divisions {
+			if entry.Rank == string(division) {
+				return i, i*len(lol.Divisions) + len(lol.Divisions) - 1 - j, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// Tracker records the league entries of players and reports how their standings changed
+type Tracker struct {
+	client *lol.LeagueClient
+	store  Store
+	now    func() time.Time
+}
+
+// New returns a Tracker requesting league entries with the client and keeping snapshots in the store
+func New(client *lol.LeagueClient, store Store) *Tracker {
+	return &Tracker{
+		client: client,
+		store:  store,
+		now:    time.Now,
+	}
+}
+
+// Update requests the league entries of the player with the given PUUID and records them. It returns the diffs
+// of all queues whose standing changed since the last update, including queues the player was not tracked in.
+func (t *Tracker) Update(ctx context.Context, puuid string) ([]*Diff, error) {
+	entries, err := t.client.ListByPUUIDWithContext(ctx, puuid)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []*Diff
+	for _, entry := range entries {
+		if entry.PUUID == "" {
+			entry.PUUID = puuid
+		}
+		diff, err := t.Record(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+		if diff.Changed() {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs, nil
+}
+
+// Record compares the entry to the latest snapshot of the player in its queue. A new snapshot is only appended
+// to the store if the standing changed.
+func (t *Tracker) Record(ctx context.Context, entry *lol.LeagueItem) (*Diff, error) {
+	previous, err := t.store.Latest(ctx, entry.PUUID, entry.QueueType)
+	if err != nil {
+		return nil, err
+	}
+	diff := Compare(previous, &Snapshot{Entry: entry, TakenAt: t.now()})
+	if !diff.Changed() {
+		return diff, nil
+	}
+	if err := t.store.Append(ctx, entry.PUUID, entry.QueueType, diff.Current); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
@@ -0,0 +1,214 @@
+package standing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+func snapshot(tier, rank string, lp, wins, losses int, hotStreak bool) *Snapshot {
+	return &Snapshot{Entry: &lol.LeagueItem{
+		PUUID:        "puuid",
+		QueueType:    "RANKED_SOLO_5x5",
+		Tier:         tier,
+		Rank:         rank,
+		LeaguePoints: lp,
+		Wins:         wins,
+		Losses:       losses,
+		HotStreak:    hotStreak,
+	}}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		previous *Snapshot
+		current  *Snapshot
+		want     Diff
+		reset    bool
+		changed  bool
+	}{
+		{
+			name:    "first snapshot",
+			current: snapshot("GOLD", "I", 50, 10, 10, false),
+			changed: true,
+		},
+		{
+			name:     "unchanged",
+			previous: snapshot("GOLD", "I", 50, 10, 10, false),
+			current:  snapshot("GOLD", "I", 50, 10, 10, false),
+		},
+		{
+			name:     "win",
+			previous: snapshot("GOLD", "II", 50, 10, 10, false),
+			current:  snapshot("GOLD", "II", 68, 11, 10, false),
+			want:     Diff{LeaguePoints: 18, Wins: 1},
+			changed:  true,
+		},
+		{
+			name:     "promotion to next tier",
+			previous: snapshot("GOLD", "I", 90, 10, 10, false),
+			current:  snapshot("PLATINUM", "IV", 8, 11, 10, false),
+			want:     Diff{LeaguePoints: 18, Wins: 1, Movement: MovementPromotion, TierChanged: true},
+			changed:  true,
+		},
+		{
+			name:     "demotion",
+			previous: snapshot("SILVER", "III", 10, 20, 20, true),
+			current:  snapshot("SILVER", "IV", 75, 20, 22, false),
+			want:     Diff{LeaguePoints: -35, Losses: 2, Movement: MovementDemotion, HotStreakChanged: true},
+			changed:  true,
+		},
+		{
+			name:     "promotion to master",
+			previous: snapshot("DIAMOND", "I", 95, 10, 10, false),
+			current:  snapshot("MASTER", "I", 15, 11, 10, true),
+			want: Diff{
+				LeaguePoints: 20, Wins: 1, Movement: MovementPromotion, TierChanged: true, HotStreakChanged: true,
+			},
+			changed: true,
+		},
+		{
+			name:     "apex tier change",
+			previous: snapshot("GRANDMASTER", "I", 480, 10, 10, false),
+			current:  snapshot("MASTER", "I", 460, 10, 11, false),
+			want:     Diff{LeaguePoints: -20, Losses: 1, Movement: MovementDemotion, TierChanged: true},
+			changed:  true,
+		},
+		{
+			name:     "season reset",
+			previous: snapshot("DIAMOND", "II", 40, 120, 100, true),
+			current:  snapshot("EMERALD", "I", 20, 1, 0, false),
+			reset:    true,
+			changed:  true,
+		},
+		{
+			name:     "unknown tier",
+			previous: snapshot("WOOD", "I", 10, 10, 10, false),
+			current:  snapshot("GOLD", "I", 30, 11, 10, false),
+			want:     Diff{LeaguePoints: 20, Wins: 1, TierChanged: true},
+			changed:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Compare(tt.previous, tt.current)
+			tt.want.PUUID = "puuid"
+			tt.want.Queue = "RANKED_SOLO_5x5"
+			if !tt.reset {
+				tt.want.Previous = tt.previous
+			}
+			tt.want.Current = tt.current
+			assert.Equal(t, &tt.want, got)
+			assert.Equal(t, tt.changed, got.Changed())
+		})
+	}
+}
+
+func TestMovement_String(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "none", MovementNone.String())
+	assert.Equal(t, "promotion", MovementPromotion.String())
+	assert.Equal(t, "demotion", MovementDemotion.String())
+}
+
+func TestTracker_Update(t *testing.T) {
+	t.Parallel()
+	responses := [][]*lol.LeagueItem{
+		{
+			{QueueType: "RANKED_SOLO_5x5", Tier: "GOLD", Rank: "I", LeaguePoints: 90},
+			{QueueType: "RANKED_FLEX_SR", Tier: "SILVER", Rank: "II", LeaguePoints: 10},
+		},
+		{
+			{QueueType: "RANKED_SOLO_5x5", Tier: "PLATINUM", Rank: "IV", LeaguePoints: 8, Wins: 1},
+			{QueueType: "RANKED_FLEX_SR", Tier: "SILVER", Rank: "II", LeaguePoints: 10},
+		},
+	}
+	var requests int
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			assert.Equal(t, "/lol/league/v4/entries/by-puuid/puuid", r.URL.Path)
+			response := responses[requests]
+			requests++
+			return mock.NewJSONMockDoer(response, 200).Do(r)
+		},
+	}
+	client := lol.NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+	store := NewMemoryStore()
+	tracker := New(client.League, store)
+	tracker.now = func() time.Time { return time.Unix(int64(requests), 0) }
+
+	diffs, err := tracker.Update(context.Background(), "puuid")
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	assert.Nil(t, diffs[0].Previous)
+	assert.Equal(t, "puuid", diffs[0].PUUID)
+	assert.Equal(t, "RANKED_FLEX_SR", diffs[1].Queue)
+
+	diffs, err = tracker.Update(context.Background(), "puuid")
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "RANKED_SOLO_5x5", diffs[0].Queue)
+	assert.Equal(t, 18, diffs[0].LeaguePoints)
+	assert.Equal(t, MovementPromotion, diffs[0].Movement)
+
+	history, err := store.History(context.Background(), "puuid", "RANKED_SOLO_5x5")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, time.Unix(2, 0), history[1].TakenAt)
+	history, err = store.History(context.Background(), "puuid", "RANKED_FLEX_SR")
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+}
+
+type failingStore struct {
+	*MemoryStore
+	err error
+}
+
+func (s failingStore) Append(context.Context, string, string, *Snapshot) error {
+	return s.err
+}
+
+func TestTracker_UpdateErrors(t *testing.T) {
+	t.Parallel()
+	errStore := errors.New("store")
+	tests := []struct {
+		name  string
+		doer  internal.Doer
+		store Store
+		want  error
+	}{
+		{
+			name:  "request",
+			doer:  mock.NewStatusMockDoer(http.StatusForbidden),
+			store: NewMemoryStore(),
+			want:  api.ErrForbidden,
+		},
+		{
+			name:  "store",
+			doer:  mock.NewJSONMockDoer([]*lol.LeagueItem{{QueueType: "RANKED_SOLO_5x5"}}, 200),
+			store: failingStore{MemoryStore: NewMemoryStore(), err: errStore},
+			want:  errStore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := lol.NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger()))
+			_, err := New(client.League, tt.store).Update(context.Background(), "puuid")
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package standing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// MemoryStore is a Store keeping snapshots in memory
+type MemoryStore struct {
+	mu        sync.Mutex
+	snapshots map[string][]Snapshot
+}
+
+// NewMemoryStore returns a new empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{snapshots: map[string][]Snapshot{}}
+}
+
+// Latest implements the Store interface
+func (s *MemoryStore) Latest(_ context.Context, puuid, queue string) (*Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshots := s.snapshots[key(puuid, queue)]
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+	snapshot := snapshots[len(snapshots)-1]
+	return &snapshot, nil
+}
+
+// Append implements the Store interface
+func (s *MemoryStore) Append(_ context.Context, puuid, queue string, snapshot *Snapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[key(puuid, queue)] = append(s.snapshots[key(puuid, queue)], *snapshot)
+	return nil
+}
+
+// History returns all snapshots of the player with the given PUUID in the queue, oldest first
+func (s *MemoryStore) History(_ context.Context, puuid, queue string) ([]Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Snapshot(nil), s.snapshots[key(puuid, queue)]...), nil
+}
+
+// FileStore is a Store keeping the snapshots of every player and queue as a JSON Lines file in a directory
+type FileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileStore returns a new store keeping its snapshots in the given directory, creating it if necessary
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Latest implements the Store interface
+func (s *FileStore) Latest(ctx context.Context, puuid, queue string) (*Snapshot, error) {
+	snapshots, err := s.History(ctx, puuid, queue)
+	if err != nil || len(snapshots) == 0 {
+		return nil, err
+	}
+	return &snapshots[len(snapshots)-1], nil
+}
+
+// Append implements the Store interface
+func (s *FileStore) Append(_ context.Context, puuid, queue string, snapshot *Snapshot) error {
+	content, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file, err := os.OpenFile(s.path(puuid, queue), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(content, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// History returns all snapshots of the player with the given PUUID in the queue, oldest first
+func (s *FileStore) History(_ context.Context, puuid, queue string) ([]Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file, err := os.Open(s.path(puuid, queue))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var snapshots []Snapshot
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var snapshot Snapshot
+		if err := json.Unmarshal(scanner.Bytes(), &snapshot); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, scanner.Err()
+}
+
+func (s *FileStore) path(puuid, queue string) string {
+	return filepath.Join(s.dir, filepath.Base(key(puuid, queue))+".jsonl")
+}
+
+func key(puuid, queue string) string {
+	return puuid + "_" + queue
+}
@@ -0,0 +1,63 @@
+package standing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+type historyStore interface {
+	Store
+	History(ctx context.Context, puuid, queue string) ([]Snapshot, error)
+}
+
+func TestStores(t *testing.T) {
+	t.Parallel()
+	fileStore, err := NewFileStore(filepath.Join(t.TempDir(), "standings"))
+	require.NoError(t, err)
+	for name, store := range map[string]historyStore{"memory": NewMemoryStore(), "file": fileStore} {
+		store := store
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			latest, err := store.Latest(ctx, "puuid", "RANKED_SOLO_5x5")
+			require.NoError(t, err)
+			assert.Nil(t, latest)
+
+			first := &Snapshot{Entry: &lol.LeagueItem{PUUID: "puuid", LeaguePoints: 10}, TakenAt: time.Unix(1, 0).UTC()}
+			second := &Snapshot{Entry: &lol.LeagueItem{PUUID: "puuid", LeaguePoints: 30}, TakenAt: time.Unix(2, 0).UTC()}
+			require.NoError(t, store.Append(ctx, "puuid", "RANKED_SOLO_5x5", first))
+			require.NoError(t, store.Append(ctx, "puuid", "RANKED_SOLO_5x5", second))
+			require.NoError(t, store.Append(ctx, "puuid", "RANKED_FLEX_SR", first))
+
+			latest, err = store.Latest(ctx, "puuid", "RANKED_SOLO_5x5")
+			require.NoError(t, err)
+			assert.Equal(t, second, latest)
+			history, err := store.History(ctx, "puuid", "RANKED_SOLO_5x5")
+			require.NoError(t, err)
+			assert.Equal(t, []Snapshot{*first, *second}, history)
+			history, err = store.History(ctx, "puuid", "RANKED_FLEX_SR")
+			require.NoError(t, err)
+			assert.Equal(t, []Snapshot{*first}, history)
+		})
+	}
+}
+
+func TestFileStore_Invalid(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "puuid_RANKED_SOLO_5x5.jsonl"), []byte("{\n"), 0o600))
+	_, err = store.Latest(context.Background(), "puuid", "RANKED_SOLO_5x5")
+	assert.Error(t, err)
+	_, err = NewFileStore(filepath.Join(dir, "puuid_RANKED_SOLO_5x5.jsonl", "dir"))
+	assert.Error(t, err)
+}
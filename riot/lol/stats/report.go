@@ -0,0 +1,260 @@
+package stats
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// RecentFormLength is the number of games included in Report.RecentForm
+const RecentFormLength = 10
+
+// Record contains the aggregated performance of a player over a set of games
+type Record struct {
+	Games   int     `json:"games"`
+	Wins    int     `json:"wins"`
+	Losses  int     `json:"losses"`
+	WinRate float64 `json:"winRate"`
+	Kills   int     `json:"kills"`
+	Deaths  int     `json:"deaths"`
+	Assists int     `json:"assists"`
+	// KDA is computed from the summed up kills, deaths and assists
+	KDA float64 `json:"kda"`
+	// The following metrics are averaged over all games
+	CSPerMinute       float64 `json:"csPerMinute"`
+	KillParticipation float64 `json:"killParticipation"`
+	DamageShare       float64 `json:"damageShare"`
+	GoldShare         float64 `json:"goldShare"`
+	VisionPerMinute   float64 `json:"visionPerMinute"`
+}
+
+// Streak is a series of consecutive wins or losses
+type Streak struct {
+	Win    bool `json:"win"`
+	Length int  `json:"length"`
+}
+
+// Report is the performance of a player aggregated over many matches
+type Report struct {
+	PUUID   string  `json:"puuid"`
+	Overall *Record `json:"overall"`
+	// Champions is keyed by the champion name
+	Champions map[string]*Record `json:"champions"`
+	// Roles is keyed by the team position. Games without a position are keyed by NONE.
+	Roles map[string]*Record `json:"roles"`
+	// Queues is keyed by the queue ID
+	Queues map[int]*Record `json:"queues"`
+	// Patches is keyed by the patch, e.g. 14.1
+	Patches       map[string]*Record `json:"patches"`
+	CurrentStreak Streak             `json:"currentStreak"`
+	LongestWin    int                `json:"longestWinStreak"`
+	LongestLoss   int                `json:"longestLossStreak"`
+	// RecentForm contains a W or L for each of the last RecentFormLength games, newest first
+	RecentForm string `json:"recentForm"`
+}
+
+// Aggregator builds a Report for a player from matches added one by one
+type Aggregator struct {
+	puuid string
+	games []game
+	seen  map[string]struct{}
+}
+
+type game struct {
+	created  int64
+	champion string
+	role     string
+	queue    int
+	patch    string
+	win      bool
+	kills    int
+	deaths   int
+	assists  int
+	metrics  *Participant
+}
+
+// NewAggregator returns an Aggregator for the player with the given PUUID
+func NewAggregator(puuid string) *Aggregator {
+	return &Aggregator{
+		puuid: puuid,
+		seen:  map[string]struct{}{},
+	}
+}
+
+// Add adds the match to the report. Matches which were already added are ignored.
+func (a *Aggregator) Add(match *lol.Match) error {
+	metrics, err := ForPUUID(match, a.puuid)
+	if err != nil {
+		return err
+	}
+	if match.Metadata != nil && match.Metadata.MatchID != "" {
+		if _, ok := a.seen[match.Metadata.MatchID]; ok {
+			return nil
+		}
+		a.seen[match.Metadata.MatchID] = struct{}{}
+	}
+	p := participant(match.Info, a.puuid)
+	role := p.TeamPosition
+	if role == "" {
+		role = "NONE"
+	}
+	a.games = append(a.games, game{
+		created:  match.Info.GameCreation,
+		champion: p.ChampionName,
+		role:     role,
+		queue:    match.Info.QueueID,
+		patch:    Patch(match.Info.GameVersion),
+		win:      p.Win,
+		kills:    p.Kills,
+		deaths:   p.Deaths,
+		assists:  p.Assists,
+		metrics:  metrics,
+	})
+	return nil
+}
+
+// Report returns the report for all matches added so far
+func (a *Aggregator) Report() *Report {
+	report := &Report{
+		PUUID:     a.puuid,
+		Overall:   &Record{},
+		Champions: map[string]*Record{},
+		Roles:     map[string]*Record{},
+		Queues:    map[int]*Record{},
+		Patches:   map[string]*Record{},
+	}
+	games := append([]game(nil), a.games...)
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].created < games[j].created
+	})
+	var form strings.Builder
+	for i, g := range games {
+		report.Overall.add(g)
+		record(report.Champions, g.champion).add(g)
+		record(report.Roles, g.role).add(g)
+		record(report.Queues, g.queue).add(g)
+		record(report.Patches, g.patch).add(g)
+		report.addStreak(g.win)
+		if len(games)-i <= RecentFormLength {
+			form.WriteString(result(g.win))
+		}
+	}
+	report.RecentForm = reverse(form.String())
+	report.Overall.finish()
+	for _, records := range []map[string]*Record{report.Champions, report.Roles, report.Patches} {
+		for _, r := range records {
+			r.finish()
+		}
+	}
+	for _, r := range report.Queues {
+		r.finish()
+	}
+	return report
+}
+
+// Aggregate returns the report of the player with the given PUUID over the matches
+func Aggregate(puuid string, matches []*lol.Match) (*Report, error) {
+	a := NewAggregator(puuid)
+	for _, match := range matches {
+		if err := a.Add(match); err != nil {
+			return nil, err
+		}
+	}
+	return a.Report(), nil
+}
+
+// AggregateStream is like Aggregate but consumes the results of lol.MatchClient.FetchStream until the channel is
+// closed. Failed results are skipped and their errors are returned together with the report.
+func AggregateStream(puuid string, results <-chan lol.MatchFetchResult) (*Report, error) {
+	a := NewAggregator(puuid)
+	var errs []error
+	for result := range results {
+		if result.Error == nil {
+			result.Error = a.Add(result.Match)
+		}
+		if result.Error != nil {
+			errs = append(errs, result.Error)
+		}
+	}
+	return a.Report(), errors.Join(errs...)
+}
+
+// Patch returns the patch of a game version, e.g. 14.1 for 14.1.555.5828
+func Patch(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+	return parts[0] + "." + parts[1]
+}
+
+func record[K comparable](records map[K]*Record, key K) *Record {
+	r, ok := records[key]
+	if !ok {
+		r = &Record{}
+		records[key] = r
+	}
+	return r
+}
+
+func (r *Record) add(g game) {
+	r.Games++
+	if g.win {
+		r.Wins++
+	} else {
+		r.Losses++
+	}
+	r.Kills += g.kills
+	r.Deaths += g.deaths
+	r.Assists += g.assists
+	// the averages are summed up here and divided in finish
+	r.CSPerMinute += g.metrics.CSPerMinute
+	r.KillParticipation += g.metrics.KillParticipation
+	r.DamageShare += g.metrics.DamageShare
+	r.GoldShare += g.metrics.GoldShare
+	r.VisionPerMinute += g.metrics.VisionPerMinute
+}
+
+func (r *Record) finish() {
+	if r.Games == 0 {
+		return
+	}
+	games := float64(r.Games)
+	r.WinRate = float64(r.Wins) / games
+	r.KDA = KDA(&lol.Participant{Kills: r.Kills, Deaths: r.Deaths, Assists: r.Assists})
+	r.CSPerMinute /= games
+	r.KillParticipation /= games
+	r.DamageShare /= games
+	r.GoldShare /= games
+	r.VisionPerMinute /= games
+}
+
+func (r *Report) addStreak(win bool) {
+	if r.CurrentStreak.Length == 0 || r.CurrentStreak.Win != win {
+		r.CurrentStreak = Streak{Win: win}
+	}
+	r.CurrentStreak.Length++
+	if win && r.CurrentStreak.Length > r.LongestWin {
+		r.LongestWin = r.CurrentStreak.Length
+	}
+	if !win && r.CurrentStreak.Length > r.LongestLoss {
+		r.LongestLoss = r.CurrentStreak.Length
+	}
+}
+
+func result(win bool) string {
+	if win {
+		return "W"
+	}
+	return "L"
+}
+
+func reverse(s string) string {
+	b := []byte(s)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
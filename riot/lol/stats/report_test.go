@@ -0,0 +1,97 @@
+package stats
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// playerMatch returns a match of the player a created at the given time
+func playerMatch(created int64, champion, role string, queue int, win bool) *lol.Match {
+	return &lol.Match{
+		Metadata: &lol.MatchMetadata{MatchID: fmt.Sprintf("EUW1_%d", created)},
+		Info: &lol.MatchInfo{
+			GameCreation:     created,
+			GameDuration:     1200,
+			GameEndTimestamp: created + 1200000,
+			GameVersion:      "14.1.555.5828",
+			QueueID:          queue,
+			Participants: []*lol.Participant{
+				{
+					PUUID: "a", TeamID: 100, ChampionName: champion, TeamPosition: role, Win: win,
+					Kills: 2, Deaths: 1, Assists: 2, TotalMinionsKilled: 200,
+				},
+				{PUUID: "b", TeamID: 100, Kills: 2},
+			},
+		},
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	t.Parallel()
+	matches := []*lol.Match{
+		playerMatch(4, "Ahri", "MIDDLE", 420, true),
+		playerMatch(1, "Ahri", "MIDDLE", 420, true),
+		playerMatch(2, "Lux", "UTILITY", 440, false),
+		playerMatch(3, "Ahri", "", 420, false),
+		playerMatch(5, "Lux", "UTILITY", 420, true),
+		playerMatch(5, "Lux", "UTILITY", 420, true),
+	}
+	report, err := Aggregate("a", matches)
+	require.NoError(t, err)
+	assert.Equal(t, &Record{
+		Games: 5, Wins: 3, Losses: 2, WinRate: 0.6, Kills: 10, Deaths: 5, Assists: 10, KDA: 4,
+		CSPerMinute: 10, KillParticipation: 1,
+	}, report.Overall)
+	assert.Equal(t, 3, report.Champions["Ahri"].Games)
+	assert.InDelta(t, 2.0/3, report.Champions["Ahri"].WinRate, 1e-9)
+	assert.Equal(t, 1, report.Roles["UTILITY"].Wins)
+	assert.Equal(t, 1, report.Roles["NONE"].Losses)
+	assert.Equal(t, 4, report.Queues[420].Games)
+	assert.Equal(t, 5, report.Patches["14.1"].Games)
+	assert.Equal(t, Streak{Win: true, Length: 2}, report.CurrentStreak)
+	assert.Equal(t, 2, report.LongestWin)
+	assert.Equal(t, 2, report.LongestLoss)
+	assert.Equal(t, "WWLLW", report.RecentForm)
+
+	content, err := json.Marshal(report)
+	require.NoError(t, err)
+	var decoded *Report
+	require.NoError(t, json.Unmarshal(content, &decoded))
+	assert.Equal(t, report, decoded)
+
+	_, err = Aggregate("c", matches)
+	require.ErrorIs(t, err, ErrParticipantNotFound)
+}
+
+func TestAggregateStream(t *testing.T) {
+	t.Parallel()
+	errFetch := errors.New("fetch")
+	results := make(chan lol.MatchFetchResult, 3)
+	results <- lol.MatchFetchResult{Match: playerMatch(1, "Ahri", "MIDDLE", 420, true)}
+	results <- lol.MatchFetchResult{MatchID: "EUW1_2", Error: errFetch}
+	results <- lol.MatchFetchResult{Match: &lol.Match{}}
+	close(results)
+	report, err := AggregateStream("a", results)
+	require.ErrorIs(t, err, errFetch)
+	require.ErrorIs(t, err, ErrMissingInfo)
+	assert.Equal(t, 1, report.Overall.Games)
+}
+
+func TestPatch(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"14.1.555.5828": "14.1",
+		"9.24":          "9.24",
+		"":              "",
+	}
+	for version, want := range tests {
+		assert.Equal(t, want, Patch(version))
+	}
+}
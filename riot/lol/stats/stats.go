@@ -0,0 +1,133 @@
+// Package stats computes metrics commonly derived from the participants of a match, like KDA or CS per minute
+package stats
+
+import (
+	"errors"
+	"time"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+var (
+	// ErrMissingInfo is returned for matches without info
+	ErrMissingInfo = errors.New("match has no info")
+	// ErrParticipantNotFound is returned if the requested participant did not play in the match
+	ErrParticipantNotFound = errors.New("participant not found")
+)
+
+// Participant contains the metrics of a single participant of a match
+type Participant struct {
+	PUUID         string
+	ParticipantID int
+	TeamID        int
+	// KDA is (kills + assists) / deaths, where no deaths count as one death
+	KDA float64
+	// CS is the number of lane minions and neutral monsters killed
+	CS          int
+	CSPerMinute float64
+	// KillParticipation is the share of the team's kills the participant killed or assisted in
+	KillParticipation float64
+	// DamageShare is the share of the team's damage to champions dealt by the participant
+	DamageShare     float64
+	DamagePerMinute float64
+	// GoldShare is the share of the team's gold earned by the participant
+	GoldShare       float64
+	GoldPerMinute   float64
+	VisionPerMinute float64
+}
+
+// team contains the totals of a team the shares of participants are computed from
+type team struct {
+	kills, damage, gold int
+}
+
+// ForMatch returns the metrics of all participants of the match
+func ForMatch(match *lol.Match) ([]*Participant, error) {
+	if match == nil || match.Info == nil {
+		return nil, ErrMissingInfo
+	}
+	teams := teamTotals(match.Info)
+	minutes := match.Info.Duration().Minutes()
+	participants := make([]*Participant, 0, len(match.Info.Participants))
+	for _, p := range match.Info.Participants {
+		participants = append(participants, compute(p, teams[p.TeamID], minutes))
+	}
+	return participants, nil
+}
+
+// ForPUUID returns the metrics of the participant with the given PUUID
+func ForPUUID(match *lol.Match, puuid string) (*Participant, error) {
+	if match == nil || match.Info == nil {
+		return nil, ErrMissingInfo
+	}
+	p := participant(match.Info, puuid)
+	if p == nil {
+		return nil, ErrParticipantNotFound
+	}
+	return compute(p, teamTotals(match.Info)[p.TeamID], match.Info.Duration().Minutes()), nil
+}
+
+func participant(info *lol.MatchInfo, puuid string) *lol.Participant {
+	for _, p := range info.Participants {
+		if p.PUUID == puuid {
+			return p
+		}
+	}
+	return nil
+}
+
+// KDA returns (kills + assists) / deaths of the participant, where no deaths count as one death
+func KDA(p *lol.Participant) float64 {
+	deaths := p.Deaths
+	if deaths == 0 {
+		deaths = 1
+	}
+	return float64(p.Kills+p.Assists) / float64(deaths)
+}
+
+// CS returns the number of lane minions and neutral monsters killed by the participant
+func CS(p *lol.Participant) int {
+	return p.TotalMinionsKilled + p.NeutralMinionsKilled
+}
+
+// PerMinute returns the value averaged over the duration of the match
+func PerMinute(value int, duration time.Duration) float64 {
+	return ratio(value, duration.Minutes())
+}
+
+func compute(p *lol.Participant, t team, minutes float64) *Participant {
+	return &Participant{
+		PUUID:             p.PUUID,
+		ParticipantID:     p.ParticipantID,
+		TeamID:            p.TeamID,
+		KDA:               KDA(p),
+		CS:                CS(p),
+		CSPerMinute:       ratio(CS(p), minutes),
+		KillParticipation: ratio(p.Kills+p.Assists, float64(t.kills)),
+		DamageShare:       ratio(p.TotalDamageDealtToChampions, float64(t.damage)),
+		DamagePerMinute:   ratio(p.TotalDamageDealtToChampions, minutes),
+		GoldShare:         ratio(p.GoldEarned, float64(t.gold)),
+		GoldPerMinute:     ratio(p.GoldEarned, minutes),
+		VisionPerMinute:   ratio(p.VisionScore, minutes),
+	}
+}
+
+func teamTotals(info *lol.MatchInfo) map[int]team {
+	teams := map[int]team{}
+	for _, p := range info.Participants {
+		t := teams[p.TeamID]
+		t.kills += p.Kills
+		t.damage += p.TotalDamageDealtToChampions
+		t.gold += p.GoldEarned
+		teams[p.TeamID] = t
+	}
+	return teams
+}
+
+// ratio returns value / total or 0 if total is 0
+func ratio(value int, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(value) / total
+}
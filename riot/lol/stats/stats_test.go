@@ -0,0 +1,105 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+func testMatch(gameDuration int, gameEndTimestamp int64) *lol.Match {
+	return &lol.Match{
+		Info: &lol.MatchInfo{
+			GameDuration:     gameDuration,
+			GameEndTimestamp: gameEndTimestamp,
+			Participants: []*lol.Participant{
+				{
+					PUUID: "a", ParticipantID: 1, TeamID: 100, Kills: 6, Deaths: 2, Assists: 4,
+					TotalMinionsKilled: 180, NeutralMinionsKilled: 20, TotalDamageDealtToChampions: 15000,
+					GoldEarned: 12000, VisionScore: 30,
+				},
+				{
+					PUUID: "b", ParticipantID: 2, TeamID: 100, Kills: 4, Deaths: 0, Assists: 6,
+					TotalMinionsKilled: 20, TotalDamageDealtToChampions: 5000, GoldEarned: 8000, VisionScore: 60,
+				},
+				{PUUID: "c", ParticipantID: 6, TeamID: 200, Deaths: 10},
+			},
+		},
+	}
+}
+
+func TestForPUUID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		match   *lol.Match
+		puuid   string
+		want    *Participant
+		wantErr error
+	}{
+		{
+			name:  "duration in seconds",
+			match: testMatch(1200, 1700000000000),
+			puuid: "a",
+			want: &Participant{
+				PUUID: "a", ParticipantID: 1, TeamID: 100, KDA: 5, CS: 200, CSPerMinute: 10,
+				KillParticipation: 1, DamageShare: 0.75, DamagePerMinute: 750, GoldShare: 0.6, GoldPerMinute: 600,
+				VisionPerMinute: 1.5,
+			},
+		},
+		{
+			name:  "duration in milliseconds",
+			match: testMatch(1200000, 0),
+			puuid: "b",
+			want: &Participant{
+				PUUID: "b", ParticipantID: 2, TeamID: 100, KDA: 10, CS: 20, CSPerMinute: 1,
+				KillParticipation: 1, DamageShare: 0.25, DamagePerMinute: 250, GoldShare: 0.4, GoldPerMinute: 400,
+				VisionPerMinute: 3,
+			},
+		},
+		{
+			name:  "no team kills",
+			match: testMatch(1200, 1700000000000),
+			puuid: "c",
+			want:  &Participant{PUUID: "c", ParticipantID: 6, TeamID: 200},
+		},
+		{
+			name:    "not found",
+			match:   testMatch(1200, 1700000000000),
+			puuid:   "d",
+			wantErr: ErrParticipantNotFound,
+		},
+		{
+			name:    "missing info",
+			match:   &lol.Match{},
+			wantErr: ErrMissingInfo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ForPUUID(tt.match, tt.puuid)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestForMatch(t *testing.T) {
+	t.Parallel()
+	got, err := ForMatch(testMatch(1200, 1700000000000))
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, []string{"a", "b", "c"}, []string{got[0].PUUID, got[1].PUUID, got[2].PUUID})
+	assert.InDelta(t, 0.75, got[0].DamageShare, 1e-9)
+	_, err = ForMatch(nil)
+	require.ErrorIs(t, err, ErrMissingInfo)
+}
+
+func TestPerMinute(t *testing.T) {
+	t.Parallel()
+	assert.InDelta(t, 7.5, PerMinute(150, 20*time.Minute), 1e-9)
+	assert.Zero(t, PerMinute(150, 0))
+}
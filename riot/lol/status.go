@@ -1,9 +1,10 @@
 package lol
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // StatusClient provides methods for the status endpoints of the League of Legends API.
@@ -13,9 +14,14 @@ type StatusClient struct {
 
 // Get returns the current status of the services for the Region
 func (s *StatusClient) Get() (*Status, error) {
+	return s.GetWithContext(context.Background())
+}
+
+// GetWithContext is like Get but binds the request to the given context
+func (s *StatusClient) GetWithContext(ctx context.Context) (*Status, error) {
 	logger := s.logger().WithField("method", "Get")
 	var status *Status
-	if err := s.c.GetInto(endpointGetStatus, &status); err != nil {
+	if err := s.c.GetIntoWithContext(ctx, endpointGetStatus, &status); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
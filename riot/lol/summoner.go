@@ -1,11 +1,12 @@
 package lol
 
 import (
+	"context"
 	"fmt"
-
-	log "github.com/sirupsen/logrus"
+	"strings"
 
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // SummonerClient provides methods for the summoner endpoints of the League of Legends API.
@@ -13,36 +14,102 @@ type SummonerClient struct {
 	c *internal.Client
 }
 
+// GetByPUUID returns the summoner with the given PUUID. Use account.Client.GetByRiotID to look up the PUUID of a
+// player by their Riot ID.
+func (s *SummonerClient) GetByPUUID(puuid string) (*Summoner, error) {
+	return s.GetByPUUIDWithContext(context.Background(), puuid)
+}
+
+// GetByPUUIDWithContext is like GetByPUUID but binds the request to the given context
+func (s *SummonerClient) GetByPUUIDWithContext(ctx context.Context, puuid string) (*Summoner, error) {
+	return s.getBy(ctx, identificationPUUID, puuid, s.logger().WithField("method", "GetByPUUID"))
+}
+
+// GetByRSOPUUID returns the summoner with the given PUUID obtained via Riot Sign On
+func (s *SummonerClient) GetByRSOPUUID(rsoPUUID string) (*Summoner, error) {
+	return s.GetByRSOPUUIDWithContext(context.Background(), rsoPUUID)
+}
+
+// GetByRSOPUUIDWithContext is like GetByRSOPUUID but binds the request to the given context
+func (s *SummonerClient) GetByRSOPUUIDWithContext(ctx context.Context, rsoPUUID string) (*Summoner, error) {
+	logger := s.logger().WithField("method", "GetByRSOPUUID")
+	var summoner *Summoner
+	ctx = internal.WithEndpoint(ctx, endpointGetSummonerByRSOPUUID)
+	if err := s.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetSummonerByRSOPUUID, rsoPUUID), &summoner); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return summoner, nil
+}
+
+// GetMe returns the summoner the given access token was issued for, e.g. an access token obtained via Riot Sign On
+func (s *SummonerClient) GetMe(accessToken string) (*Summoner, error) {
+	return s.GetMeWithContext(context.Background(), accessToken)
+}
+
+// GetMeWithContext is like GetMe but binds the request to the given context
+func (s *SummonerClient) GetMeWithContext(ctx context.Context, accessToken string) (*Summoner, error) {
+	logger := s.logger().WithField("method", "GetMe")
+	var summoner *Summoner
+	ctx = internal.WithEndpoint(ctx, endpointGetSummonerMe)
+	ctx = internal.WithBearerToken(ctx, accessToken)
+	if err := s.c.GetIntoWithContext(ctx, endpointGetSummonerMe, &summoner); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return summoner, nil
+}
+
 // GetByName returns the summoner with the given summoner name
+//
+// Deprecated: Riot has removed summoner names in favor of Riot IDs. Use GetByPUUID together with
+// account.Client.GetByRiotID instead. Will be removed in a future release.
 func (s *SummonerClient) GetByName(name string) (*Summoner, error) {
-	return s.getBy(identificationName, name, s.logger().WithField("method", "GetByName"))
+	return s.GetByNameWithContext(context.Background(), name)
+}
+
+// GetByNameWithContext is like GetByName but binds the request to the given context
+//
+// Deprecated: Riot has removed summoner names in favor of Riot IDs. Use GetByPUUIDWithContext together with
+// account.Client.GetByRiotIDWithContext instead. Will be removed in a future release.
+func (s *SummonerClient) GetByNameWithContext(ctx context.Context, name string) (*Summoner, error) {
+	return s.getBy(ctx, identificationName, name, s.logger().WithField("method", "GetByName"))
 }
 
 // GetByAccountID returns the summoner with the given account ID
 func (s *SummonerClient) GetByAccountID(id string) (*Summoner, error) {
-	return s.getBy(identificationAccountID, id, s.logger().WithField("method", "GetByAccountID"))
+	return s.GetByAccountIDWithContext(context.Background(), id)
 }
 
-// GetByPUUID returns the summoner with the given PUUID
-func (s *SummonerClient) GetByPUUID(puuid string) (*Summoner, error) {
-	return s.getBy(identificationPUUID, puuid, s.logger().WithField("method", "GetByPUUID"))
+// GetByAccountIDWithContext is like GetByAccountID but binds the request to the given context
+func (s *SummonerClient) GetByAccountIDWithContext(ctx context.Context, id string) (*Summoner, error) {
+	return s.getBy(ctx, identificationAccountID, id, s.logger().WithField("method", "GetByAccountID"))
 }
 
 // GetByID returns the summoner with the given ID
 func (s *SummonerClient) GetByID(summonerID string) (*Summoner, error) {
-	return s.getBy(identificationSummonerID, summonerID, s.logger().WithField("method", "GetByID"))
+	return s.GetByIDWithContext(context.Background(), summonerID)
+}
+
+// GetByIDWithContext is like GetByID but binds the request to the given context
+func (s *SummonerClient) GetByIDWithContext(ctx context.Context, summonerID string) (*Summoner, error) {
+	return s.getBy(ctx, identificationSummonerID, summonerID, s.logger().WithField("method", "GetByID"))
 }
 
-func (s *SummonerClient) getBy(by identification, value string, logger log.FieldLogger) (*Summoner, error) {
+func (s *SummonerClient) getBy(
+	ctx context.Context, by identification, value string, logger log.FieldLogger,
+) (*Summoner, error) {
 	var endpoint string
 	switch by {
 	case identificationSummonerID:
 		endpoint = fmt.Sprintf(endpointGetSummonerBySummonerID, value)
+		ctx = internal.WithEndpoint(ctx, endpointGetSummonerBySummonerID)
 	default:
 		endpoint = fmt.Sprintf(endpointGetSummonerBy, by, value)
+		ctx = internal.WithEndpoint(ctx, strings.Replace(endpointGetSummonerBy, "%s", string(by), 1))
 	}
 	var summoner *Summoner
-	if err := s.c.GetInto(endpoint, &summoner); err != nil {
+	if err := s.c.GetIntoWithContext(ctx, endpoint, &summoner); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -37,7 +37,7 @@ func TestSummonerClient_GetByName(t *testing.T) {
 				var err error
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&SummonerClient{c: client}).GetByName("name")
-				assert.Equal(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, tt.wantErr)
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -71,7 +71,7 @@ func TestSummonerClient_GetByAccountID(t *testing.T) {
 				var err error
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&SummonerClient{c: client}).GetByAccountID("accountID")
-				assert.Equal(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, tt.wantErr)
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -105,7 +105,7 @@ func TestSummonerClient_GetByPUUID(t *testing.T) {
 				var err error
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&SummonerClient{c: client}).GetByPUUID("puuid")
-				assert.Equal(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, tt.wantErr)
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -139,7 +139,87 @@ func TestSummonerClient_GetByID(t *testing.T) {
 				var err error
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&SummonerClient{c: client}).GetByID("id")
-				assert.Equal(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, tt.wantErr)
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestSummonerClient_GetByRSOPUUID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    *Summoner
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: &Summoner{PUUID: "puuid"},
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if r.URL.Path != "/fulfillment/v1/summoners/by-puuid/puuid" {
+						return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+					}
+					return mock.NewJSONMockDoer(&Summoner{PUUID: "puuid"}, 200).Do(r)
+				},
+			},
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&SummonerClient{c: client}).GetByRSOPUUID("puuid")
+				assert.ErrorIs(t, err, tt.wantErr)
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestSummonerClient_GetMe(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    *Summoner
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: &Summoner{PUUID: "puuid"},
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if r.Header.Get("Authorization") != "Bearer token" || r.URL.Path != "/lol/summoner/v4/summoners/me" {
+						return mock.NewStatusMockDoer(http.StatusUnauthorized).Do(r)
+					}
+					return mock.NewJSONMockDoer(&Summoner{PUUID: "puuid"}, 200).Do(r)
+				},
+			},
+		},
+		{
+			name:    "unauthorized",
+			wantErr: api.ErrUnauthorized,
+			doer:    mock.NewStatusMockDoer(http.StatusUnauthorized),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&SummonerClient{c: client}).GetMe("token")
+				assert.ErrorIs(t, err, tt.wantErr)
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
@@ -1,11 +1,11 @@
 package lol
 
 import (
+	"context"
 	"fmt"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // ThirdPartyCodeClient provides methods for the third party code endpoints of the
@@ -16,13 +16,19 @@ type ThirdPartyCodeClient struct {
 
 // Get returns the third party code for the given summoner id
 func (t *ThirdPartyCodeClient) Get(summonerID string) (string, error) {
+	return t.GetWithContext(context.Background(), summonerID)
+}
+
+// GetWithContext is like Get but binds the request to the given context
+func (t *ThirdPartyCodeClient) GetWithContext(ctx context.Context, summonerID string) (string, error) {
 	logger := t.logger().WithFields(
 		log.Fields{
 			"method": "Get",
 		},
 	)
 	var code string
-	if err := t.c.GetInto(fmt.Sprintf(endpointGetThirdPartyCode, summonerID), &code); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetThirdPartyCode)
+	if err := t.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetThirdPartyCode, summonerID), &code); err != nil {
 		logger.Debug(err)
 		return "", err
 	}
@@ -1,11 +1,11 @@
 package lol
 
 import (
+	"context"
 	"fmt"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // TournamentClient provides methods for the tournament endpoints of the League of Legends API.
@@ -17,6 +17,13 @@ type TournamentClient struct {
 // For more information about the parameters see the documentation for TournamentCodeParameters.
 // Set the useStub flag to true to use the stub endpoints for mocking an implementation
 func (t *TournamentClient) CreateCodes(id, count int, params *TournamentCodeParameters, stub bool) ([]string, error) {
+	return t.CreateCodesWithContext(context.Background(), id, count, params, stub)
+}
+
+// CreateCodesWithContext is like CreateCodes but binds the request to the given context
+func (t *TournamentClient) CreateCodesWithContext(
+	ctx context.Context, id, count int, params *TournamentCodeParameters, stub bool,
+) ([]string, error) {
 	logger := t.logger().WithFields(
 		log.Fields{
 			"method": "CreateCodes",
@@ -28,7 +35,8 @@ func (t *TournamentClient) CreateCodes(id, count int, params *TournamentCodePara
 		endpoint = endpointCreateStubTournamentCodes
 	}
 	var codes []string
-	if err := t.c.PostInto(fmt.Sprintf(endpoint, count, id), params, &codes); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpoint)
+	if err := t.c.PostIntoWithContext(ctx, fmt.Sprintf(endpoint, count, id), params, &codes); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -38,6 +46,13 @@ func (t *TournamentClient) CreateCodes(id, count int, params *TournamentCodePara
 // ListLobbyEvents returns the lobby events for a lobby specified by the tournament code
 // Set the useStub flag to true to use the stub endpoints for mocking an implementation
 func (t *TournamentClient) ListLobbyEvents(code string, useStub bool) (*LobbyEventList, error) {
+	return t.ListLobbyEventsWithContext(context.Background(), code, useStub)
+}
+
+// ListLobbyEventsWithContext is like ListLobbyEvents but binds the request to the given context
+func (t *TournamentClient) ListLobbyEventsWithContext(
+	ctx context.Context, code string, useStub bool,
+) (*LobbyEventList, error) {
 	logger := t.logger().WithFields(
 		log.Fields{
 			"method": "ListLobbyEvents",
@@ -49,7 +64,8 @@ func (t *TournamentClient) ListLobbyEvents(code string, useStub bool) (*LobbyEve
 		endpoint = endpointGetStubLobbyEvents
 	}
 	var events LobbyEventList
-	if err := t.c.GetInto(fmt.Sprintf(endpoint, code), &events); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpoint)
+	if err := t.c.GetIntoWithContext(ctx, fmt.Sprintf(endpoint, code), &events); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -60,6 +76,13 @@ func (t *TournamentClient) ListLobbyEvents(code string, useStub bool) (*LobbyEve
 // For more information about the parameters see the documentation for ProviderRegistrationParameters.
 // Set the useStub flag to true to use the stub endpoints for mocking an implementation
 func (t *TournamentClient) CreateProvider(parameters *ProviderRegistrationParameters, useStub bool) (int, error) {
+	return t.CreateProviderWithContext(context.Background(), parameters, useStub)
+}
+
+// CreateProviderWithContext is like CreateProvider but binds the request to the given context
+func (t *TournamentClient) CreateProviderWithContext(
+	ctx context.Context, parameters *ProviderRegistrationParameters, useStub bool,
+) (int, error) {
 	logger := t.logger().WithFields(
 		log.Fields{
 			"method": "CreateProvider",
@@ -71,7 +94,7 @@ func (t *TournamentClient) CreateProvider(parameters *ProviderRegistrationParame
 		endpoint = endpointCreateStubTournamentProvider
 	}
 	var id int
-	if err := t.c.PostInto(endpoint, parameters, &id); err != nil {
+	if err := t.c.PostIntoWithContext(ctx, endpoint, parameters, &id); err != nil {
 		logger.Debug(err)
 		return 0, err
 	}
@@ -82,6 +105,13 @@ func (t *TournamentClient) CreateProvider(parameters *ProviderRegistrationParame
 // For more information about the parameters see the documentation for TournamentRegistrationParameters.
 // Set the useStub flag to true to use the stub endpoints for mocking an implementation
 func (t *TournamentClient) Create(parameters *TournamentRegistrationParameters, useStub bool) (int, error) {
+	return t.CreateWithContext(context.Background(), parameters, useStub)
+}
+
+// CreateWithContext is like Create but binds the request to the given context
+func (t *TournamentClient) CreateWithContext(
+	ctx context.Context, parameters *TournamentRegistrationParameters, useStub bool,
+) (int, error) {
 	logger := t.logger().WithFields(
 		log.Fields{
 			"method": "Create",
@@ -93,7 +123,7 @@ func (t *TournamentClient) Create(parameters *TournamentRegistrationParameters,
 		endpoint = endpointCreateStubTournament
 	}
 	var id int
-	if err := t.c.PostInto(endpoint, parameters, &id); err != nil {
+	if err := t.c.PostIntoWithContext(ctx, endpoint, parameters, &id); err != nil {
 		logger.Debug(err)
 		return 0, err
 	}
@@ -102,13 +132,19 @@ func (t *TournamentClient) Create(parameters *TournamentRegistrationParameters,
 
 // Get returns an existing tournament
 func (t *TournamentClient) Get(code string) (*Tournament, error) {
+	return t.GetWithContext(context.Background(), code)
+}
+
+// GetWithContext is like Get but binds the request to the given context
+func (t *TournamentClient) GetWithContext(ctx context.Context, code string) (*Tournament, error) {
 	logger := t.logger().WithFields(
 		log.Fields{
 			"method": "Get",
 		},
 	)
 	var tournament Tournament
-	if err := t.c.GetInto(fmt.Sprintf(endpointGetTournament, code), &tournament); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointGetTournament)
+	if err := t.c.GetIntoWithContext(ctx, fmt.Sprintf(endpointGetTournament, code), &tournament); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -117,12 +153,20 @@ func (t *TournamentClient) Get(code string) (*Tournament, error) {
 
 // Update updates an existing tournament
 func (t *TournamentClient) Update(code string, parameters TournamentUpdateParameters) error {
+	return t.UpdateWithContext(context.Background(), code, parameters)
+}
+
+// UpdateWithContext is like Update but binds the request to the given context
+func (t *TournamentClient) UpdateWithContext(
+	ctx context.Context, code string, parameters TournamentUpdateParameters,
+) error {
 	logger := t.logger().WithFields(
 		log.Fields{
 			"method": "Update",
 		},
 	)
-	if err := t.c.Put(fmt.Sprintf(endpointUpdateTournament, code), parameters); err != nil {
+	ctx = internal.WithEndpoint(ctx, endpointUpdateTournament)
+	if err := t.c.PutWithContext(ctx, fmt.Sprintf(endpointUpdateTournament, code), parameters); err != nil {
 		logger.Debug(err)
 		return err
 	}
@@ -0,0 +1,295 @@
+// Package watcher polls the spectator API for a set of players and reports when they start or finish a game
+package watcher
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// Default values for Options
+const (
+	DefaultInterval          = time.Minute
+	DefaultRequestsPerSecond = 1
+	DefaultMatchRetry        = 30 * time.Second
+	DefaultMatchTimeout      = 15 * time.Minute
+)
+
+// Event is emitted by the Watcher. It is one of *GameStarted, *GameEnded or *PollFailed.
+type Event interface {
+	// PUUID returns the PUUID of the player the event is about
+	PUUID() string
+}
+
+// GameStarted is emitted once a player is seen in a game
+type GameStarted struct {
+	Player string
+	Game   *lol.GameInfo
+}
+
+// PUUID implements the Event interface
+func (e *GameStarted) PUUID() string {
+	return e.Player
+}
+
+// GameEnded is emitted once the game of a player has finished and the match was published. The match of a game
+// is only requested once, so the events of tracked players in the same game share Game and Match.
+type GameEnded struct {
+	Player string
+	Game   *lol.GameInfo
+	Match  *lol.Match
+	// Err is set if the match could not be retrieved within Options.MatchTimeout
+	Err error
+}
+
+// PUUID implements the Event interface
+func (e *GameEnded) PUUID() string {
+	return e.Player
+}
+
+// PollFailed is emitted if the current game of a player could not be requested
+type PollFailed struct {
+	Player string
+	Err    error
+}
+
+// PUUID implements the Event interface
+func (e *PollFailed) PUUID() string {
+	return e.Player
+}
+
+// Options configures the Watcher
+type Options struct {
+	// Interval is the time between two polls of the same player. It is raised if polling all players this often
+	// exceeds RequestsPerSecond.
+	Interval time.Duration
+	// RequestsPerSecond is the share of the spectator rate limit the watcher may use. The pace is static: it does
+	// not adapt to the rate limit state of the client, so it must leave room for the match requests of finished
+	// games and for other requests made with the same API key.
+	RequestsPerSecond float64
+	// MatchRetry is the time between two attempts to get the match of a finished game
+	MatchRetry time.Duration
+	// MatchTimeout is the time after which the watcher stops waiting for the match of a finished game to be
+	// published
+	MatchTimeout time.Duration
+}
+
+// Watcher polls the current games of tracked players
+type Watcher struct {
+	client  *lol.Client
+	options Options
+	events  chan Event
+
+	mu      sync.Mutex
+	players []string
+	games   map[string]*lol.GameInfo
+	// ended maps the IDs of finished games whose match is being requested to the players of the game
+	ended map[int][]string
+	next  int
+}
+
+// New returns a Watcher using the client. Options may be nil to use the defaults.
+func New(client *lol.Client, options *Options) *Watcher {
+	w := &Watcher{
+		client: client,
+		events: make(chan Event),
+		games:  map[string]*lol.GameInfo{},
+		ended:  map[int][]string{},
+	}
+	if options != nil {
+		w.options = *options
+	}
+	if w.options.Interval <= 0 {
+		w.options.Interval = DefaultInterval
+	}
+	if w.options.RequestsPerSecond <= 0 {
+		w.options.RequestsPerSecond = DefaultRequestsPerSecond
+	}
+	if w.options.MatchRetry <= 0 {
+		w.options.MatchRetry = DefaultMatchRetry
+	}
+	if w.options.MatchTimeout <= 0 {
+		w.options.MatchTimeout = DefaultMatchTimeout
+	}
+	return w
+}
+
+// Events returns the channel events are emitted on. It is closed once Run returns.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Add starts tracking the players with the given PUUIDs
+func (w *Watcher) Add(puuids ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, puuid := range puuids {
+		if !w.tracked(puuid) {
+			w.players = append(w.players, puuid)
+		}
+	}
+}
+
+// Remove stops tracking the players with the given PUUIDs. No GameEnded event is emitted for their current games.
+func (w *Watcher) Remove(puuids ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, puuid := range puuids {
+		for i, player := range w.players {
+			if player == puuid {
+				w.players = append(w.players[:i], w.players[i+1:]...)
+				break
+			}
+		}
+		delete(w.games, puuid)
+	}
+}
+
+// Run polls the tracked players one after the other until the context is done. Events must be received from
+// Events while Run is running.
+func (w *Watcher) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	defer close(w.events)
+	defer wg.Wait()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+		if puuid, ok := w.nextPlayer(); ok {
+			w.poll(ctx, &wg, puuid)
+		}
+		timer.Reset(w.delay())
+	}
+}
+
+// delay returns the time between two polls, so every player is polled once per interval without exceeding the
+// request budget
+func (w *Watcher) delay() time.Duration {
+	w.mu.Lock()
+	n := len(w.players)
+	w.mu.Unlock()
+	delay := time.Duration(float64(time.Second) / w.options.RequestsPerSecond)
+	if n > 0 && w.options.Interval/time.Duration(n) > delay {
+		delay = w.options.Interval / time.Duration(n)
+	}
+	return delay
+}
+
+func (w *Watcher) nextPlayer() (string, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.players) == 0 {
+		return "", false
+	}
+	w.next %= len(w.players)
+	puuid := w.players[w.next]
+	w.next++
+	return puuid, true
+}
+
+func (w *Watcher) poll(ctx context.Context, wg *sync.WaitGroup, puuid string) {
+	game, err := w.client.Spectator.GetCurrentByPUUIDWithContext(ctx, puuid)
+	if errors.Is(err, api.ErrNotFound) {
+		game, err = nil, nil
+	}
+	if err != nil {
+		if ctx.Err() == nil {
+			w.emit(ctx, &PollFailed{Player: puuid, Err: err})
+		}
+		return
+	}
+	started, ended := w.update(puuid, game)
+	if ended != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.resolve(ctx, ended)
+		}()
+	}
+	if started {
+		w.emit(ctx, &GameStarted{Player: puuid, Game: game})
+	}
+}
+
+// update records the current game of the player. It returns whether the player started a new game and the game
+// the player finished if its match has to be requested. Players finishing a game whose match is already being
+// requested are added to it instead.
+func (w *Watcher) update(puuid string, game *lol.GameInfo) (bool, *lol.GameInfo) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.tracked(puuid) {
+		// removed while the request was running
+		return false, nil
+	}
+	previous, playing := w.games[puuid]
+	if game != nil {
+		w.games[puuid] = game
+	} else {
+		delete(w.games, puuid)
+	}
+	if !playing {
+		return game != nil, nil
+	}
+	if game != nil && game.GameID == previous.GameID {
+		return false, nil
+	}
+	players, requested := w.ended[previous.GameID]
+	w.ended[previous.GameID] = append(players, puuid)
+	if requested {
+		return game != nil, nil
+	}
+	return game != nil, previous
+}
+
+// resolve waits for the match of the finished game to be published and emits GameEnded for its players
+func (w *Watcher) resolve(ctx context.Context, game *lol.GameInfo) {
+	matchCtx, cancel := context.WithTimeout(ctx, w.options.MatchTimeout)
+	defer cancel()
+	for {
+		match, err := game.GetMatchWithContext(matchCtx, w.client)
+		if !errors.Is(err, api.ErrNotFound) {
+			w.end(ctx, game, match, err)
+			return
+		}
+		if internal.Sleep(matchCtx, w.options.MatchRetry) != nil {
+			w.end(ctx, game, nil, err)
+			return
+		}
+	}
+}
+
+// end emits GameEnded for every player of the finished game
+func (w *Watcher) end(ctx context.Context, game *lol.GameInfo, match *lol.Match, err error) {
+	w.mu.Lock()
+	players := w.ended[game.GameID]
+	delete(w.ended, game.GameID)
+	w.mu.Unlock()
+	for _, puuid := range players {
+		w.emit(ctx, &GameEnded{Player: puuid, Game: game, Match: match, Err: err})
+	}
+}
+
+func (w *Watcher) emit(ctx context.Context, event Event) {
+	select {
+	case w.events <- event:
+	case <-ctx.Done():
+	}
+}
+
+func (w *Watcher) tracked(puuid string) bool {
+	for _, player := range w.players {
+		if player == puuid {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,233 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// server fakes the spectator and match endpoints
+type server struct {
+	mu sync.Mutex
+	// games maps the PUUID of a player to the ID of the game they are playing
+	games map[string]int64
+	// publishAfter is the number of match requests answered with not found before a match is published
+	publishAfter  int
+	matchRequests int
+	status        int
+}
+
+func (s *server) setGame(puuid string, id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id == 0 {
+		delete(s.games, puuid)
+		return
+	}
+	s.games[puuid] = id
+}
+
+func (s *server) Do(r *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.status != 0 {
+		return mock.NewStatusMockDoer(s.status).Do(r)
+	}
+	if puuid, ok := strings.CutPrefix(r.URL.Path, "/lol/spectator/v5/active-games/by-summoner/"); ok {
+		id, ok := s.games[puuid]
+		if !ok {
+			return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+		}
+		return mock.NewJSONMockDoer(lol.GameInfo{GameID: int(id), PlatformID: "EUW1"}, 200).Do(r)
+	}
+	s.matchRequests++
+	if s.matchRequests <= s.publishAfter {
+		return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+	}
+	id := strings.TrimPrefix(r.URL.Path, "/lol/match/v5/matches/")
+	return mock.NewJSONMockDoer(lol.Match{Metadata: &lol.MatchMetadata{MatchID: id}}, 200).Do(r)
+}
+
+func newWatcher(s *server, options *Options) *Watcher {
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", s, logrus.StandardLogger())
+	return New(lol.NewClient(client), options)
+}
+
+func next(t *testing.T, w *Watcher) Event {
+	select {
+	case event := <-w.Events():
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no event")
+		return nil
+	}
+}
+
+func TestWatcher_Run(t *testing.T) {
+	t.Parallel()
+	s := &server{games: map[string]int64{"a": 1}, publishAfter: 2}
+	w := newWatcher(
+		s, &Options{Interval: time.Millisecond, RequestsPerSecond: 1000, MatchRetry: time.Millisecond},
+	)
+	w.Add("a", "b", "a")
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error)
+	go func() {
+		errs <- w.Run(ctx)
+	}()
+
+	started, ok := next(t, w).(*GameStarted)
+	require.True(t, ok)
+	assert.Equal(t, "a", started.PUUID())
+	assert.Equal(t, 1, started.Game.GameID)
+
+	s.setGame("a", 0)
+	s.setGame("b", 2)
+	var ended *GameEnded
+	for ended == nil {
+		switch event := next(t, w).(type) {
+		case *GameStarted:
+			assert.Equal(t, "b", event.PUUID())
+		case *GameEnded:
+			ended = event
+		default:
+			require.FailNow(t, fmt.Sprintf("unexpected event %#v", event))
+		}
+	}
+	assert.Equal(t, "a", ended.PUUID())
+	require.NoError(t, ended.Err)
+	assert.Equal(t, "EUW1_1", ended.Match.Metadata.MatchID)
+
+	cancel()
+	for range w.Events() {
+	}
+	require.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestWatcher_RunSharedGame(t *testing.T) {
+	t.Parallel()
+	s := &server{games: map[string]int64{"a": 1, "b": 1}, publishAfter: 5}
+	w := newWatcher(
+		s, &Options{Interval: time.Millisecond, RequestsPerSecond: 1000, MatchRetry: 10 * time.Millisecond},
+	)
+	w.Add("a", "b")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = w.Run(ctx)
+	}()
+	for i := 0; i < 2; i++ {
+		_, ok := next(t, w).(*GameStarted)
+		require.True(t, ok)
+	}
+	s.setGame("a", 0)
+	s.setGame("b", 0)
+	var players []string
+	for i := 0; i < 2; i++ {
+		ended, ok := next(t, w).(*GameEnded)
+		require.True(t, ok)
+		require.NoError(t, ended.Err)
+		assert.Equal(t, "EUW1_1", ended.Match.Metadata.MatchID)
+		players = append(players, ended.PUUID())
+	}
+	assert.ElementsMatch(t, []string{"a", "b"}, players)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	assert.Equal(t, s.publishAfter+1, s.matchRequests)
+}
+
+func TestWatcher_RunMatchTimeout(t *testing.T) {
+	t.Parallel()
+	s := &server{games: map[string]int64{"a": 1}, publishAfter: 1 << 30}
+	w := newWatcher(s, &Options{
+		Interval:          time.Millisecond,
+		RequestsPerSecond: 1000,
+		MatchRetry:        time.Millisecond,
+		MatchTimeout:      20 * time.Millisecond,
+	})
+	w.Add("a")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = w.Run(ctx)
+	}()
+	_, ok := next(t, w).(*GameStarted)
+	require.True(t, ok)
+	s.setGame("a", 0)
+	ended, ok := next(t, w).(*GameEnded)
+	require.True(t, ok)
+	require.ErrorIs(t, ended.Err, api.ErrNotFound)
+	assert.Nil(t, ended.Match)
+}
+
+func TestWatcher_RunPollFailed(t *testing.T) {
+	t.Parallel()
+	w := newWatcher(&server{status: http.StatusForbidden}, &Options{RequestsPerSecond: 1000})
+	w.Add("a")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = w.Run(ctx)
+	}()
+	failed, ok := next(t, w).(*PollFailed)
+	require.True(t, ok)
+	assert.Equal(t, "a", failed.PUUID())
+	require.ErrorIs(t, failed.Err, api.ErrForbidden)
+}
+
+func TestWatcher_Remove(t *testing.T) {
+	t.Parallel()
+	w := New(nil, nil)
+	w.Add("a", "b", "c")
+	w.Remove("b", "d")
+	assert.Equal(t, []string{"a", "c"}, w.players)
+}
+
+func TestWatcher_delay(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		options *Options
+		players int
+		want    time.Duration
+	}{
+		{
+			name: "defaults without players",
+			want: time.Second,
+		},
+		{
+			name:    "interval shared by players",
+			options: &Options{Interval: time.Minute, RequestsPerSecond: 10},
+			players: 30,
+			want:    2 * time.Second,
+		},
+		{
+			name:    "limited by budget",
+			options: &Options{Interval: time.Minute, RequestsPerSecond: 2},
+			players: 300,
+			want:    500 * time.Millisecond,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := New(nil, tt.options)
+			for i := 0; i < tt.players; i++ {
+				w.Add(fmt.Sprint(i))
+			}
+			assert.Equal(t, tt.want, w.delay())
+		})
+	}
+}
@@ -1,15 +1,26 @@
 package lor
 
-import "github.com/KnutZuidema/golio/internal"
+import (
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
 
 // Client pools methods for the Legends of Runeterra API.
 type Client struct {
 	Ranked *RankedClient
+
+	base *internal.Client
 }
 
 // NewClient returns a new instance of a Legends of Runeterra client.
 func NewClient(base *internal.Client) *Client {
 	return &Client{
 		Ranked: &RankedClient{c: base},
+		base:   base,
 	}
 }
+
+// ForRegion returns a client for the given region sharing the HTTP client, rate limiter and cache
+func (c *Client) ForRegion(region api.Region) *Client {
+	return NewClient(c.base.WithRegion(region))
+}
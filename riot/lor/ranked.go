@@ -1,6 +1,10 @@
 package lor
 
-import "github.com/KnutZuidema/golio/internal"
+import (
+	"context"
+
+	"github.com/KnutZuidema/golio/internal"
+)
 
 // RankedClient provides methods for the ranked endpoints of the Legends of Runeterra API.
 type RankedClient struct {
@@ -9,8 +13,13 @@ type RankedClient struct {
 
 // GetMasters returns all players currently in the Master tier for the region.
 func (c *RankedClient) GetMasters() ([]*Player, error) {
+	return c.GetMastersWithContext(context.Background())
+}
+
+// GetMastersWithContext is like GetMasters but binds the request to the given context.
+func (c *RankedClient) GetMastersWithContext(ctx context.Context) ([]*Player, error) {
 	var players []*Player
-	if err := c.c.GetInto(endpointGetMaster, &players); err != nil {
+	if err := c.c.GetIntoWithContext(ctx, endpointGetMaster, &players); err != nil {
 		return nil, err
 	}
 	return players, nil
@@ -0,0 +1,49 @@
+package riot
+
+import (
+	"context"
+
+	"github.com/KnutZuidema/golio/riot/account"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// Player combines the account of a player with their League of Legends summoner and ranked entries
+type Player struct {
+	Account  *account.Account
+	Summoner *lol.Summoner
+	// Entries contains one entry for every queue the player is ranked in
+	Entries []*lol.LeagueItem
+}
+
+// ResolveRiotID returns the player with the given Riot ID of the form GameName#TagLine. The summoner and ranked
+// entries are requested from the region of the client.
+func (c *Client) ResolveRiotID(riotID string) (*Player, error) {
+	return c.ResolveRiotIDWithContext(context.Background(), riotID)
+}
+
+// ResolveRiotIDWithContext is like ResolveRiotID but binds the requests to the given context
+func (c *Client) ResolveRiotIDWithContext(ctx context.Context, riotID string) (*Player, error) {
+	logger := c.base.Logger().WithField("method", "ResolveRiotID")
+	gameName, tagLine, err := account.ParseRiotID(riotID)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	acc, err := c.Account.GetByRiotIDWithContext(ctx, gameName, tagLine)
+	if err != nil {
+		return nil, err
+	}
+	summoner, err := c.LoL.Summoner.GetByPUUIDWithContext(ctx, acc.Puuid)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.LoL.League.ListByPUUIDWithContext(ctx, acc.Puuid)
+	if err != nil {
+		return nil, err
+	}
+	return &Player{
+		Account:  acc,
+		Summoner: summoner,
+		Entries:  entries,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package riot
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/riot/account"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+func playerDoer(missing string) internal.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			switch {
+			case missing != "" && strings.Contains(r.URL.Path, missing):
+				return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+			case r.URL.Host == "asia.api.riotgames.com" &&
+				r.URL.Path == "/riot/account/v1/accounts/by-riot-id/Hide on bush/KR1":
+				return mock.NewJSONMockDoer(account.Account{Puuid: "puuid", GameName: "Hide on bush"}, 200).Do(r)
+			case r.URL.Host == "kr.api.riotgames.com" && r.URL.Path == "/lol/summoner/v4/summoners/by-puuid/puuid":
+				return mock.NewJSONMockDoer(lol.Summoner{ID: "id", PUUID: "puuid"}, 200).Do(r)
+			case r.URL.Host == "kr.api.riotgames.com" && r.URL.Path == "/lol/league/v4/entries/by-puuid/puuid":
+				return mock.NewJSONMockDoer([]lol.LeagueItem{{QueueType: "RANKED_SOLO_5x5"}}, 200).Do(r)
+			}
+			return mock.NewStatusMockDoer(http.StatusBadRequest).Do(r)
+		},
+	}
+}
+
+func TestClient_ResolveRiotID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		riotID  string
+		doer    internal.Doer
+		want    *Player
+		wantErr error
+	}{
+		{
+			name:   "resolved",
+			riotID: "Hide on bush#KR1",
+			doer:   playerDoer(""),
+			want: &Player{
+				Account:  &account.Account{Puuid: "puuid", GameName: "Hide on bush"},
+				Summoner: &lol.Summoner{ID: "id", PUUID: "puuid"},
+				Entries:  []*lol.LeagueItem{{QueueType: "RANKED_SOLO_5x5"}},
+			},
+		},
+		{
+			name:    "invalid riot id",
+			riotID:  "Hide on bush",
+			doer:    playerDoer(""),
+			wantErr: account.ErrInvalidRiotID,
+		},
+		{
+			name:    "unknown riot id",
+			riotID:  "Hide on bush#KR1",
+			doer:    playerDoer("by-riot-id"),
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name:    "no summoner",
+			riotID:  "Hide on bush#KR1",
+			doer:    playerDoer("summoners"),
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(api.RegionKorea, "API_KEY", tt.doer, logrus.StandardLogger())
+			got, err := client.ResolveRiotID(tt.riotID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
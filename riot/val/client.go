@@ -1,6 +1,9 @@
 package val
 
-import "github.com/KnutZuidema/golio/internal"
+import (
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
 
 // Client pools all methods for endpoints of the Valorant API.
 type Client struct {
@@ -8,6 +11,8 @@ type Client struct {
 	Status  *StatusClient
 	Ranked  *RankedClient
 	Match   *MatchClient
+
+	base *internal.Client
 }
 
 // NewClient returns a new instance of a League of Legends client.
@@ -17,5 +22,11 @@ func NewClient(base *internal.Client) *Client {
 		Status:  &StatusClient{c: base},
 		Ranked:  &RankedClient{c: base},
 		Match:   &MatchClient{c: base},
+		base:    base,
 	}
 }
+
+// ForRegion returns a client for the given region sharing the HTTP client, rate limiter and cache
+func (c *Client) ForRegion(region api.Region) *Client {
+	return NewClient(c.base.WithRegion(region))
+}
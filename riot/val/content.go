@@ -1,11 +1,11 @@
 package val
 
 import (
+	"context"
 	"fmt"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // ContentClient provides methods for the content endpoints of the VALORANT API.
@@ -15,13 +15,18 @@ type ContentClient struct {
 
 // GetContent returns information about the in-game contents e.g. skins, maps, etc.
 func (cc *ContentClient) GetContent(locale Locale) (*ContentInfo, error) {
+	return cc.GetContentWithContext(context.Background(), locale)
+}
+
+// GetContentWithContext is like GetContent but binds the request to the given context
+func (cc *ContentClient) GetContentWithContext(ctx context.Context, locale Locale) (*ContentInfo, error) {
 	logger := cc.logger().WithField("method", "GetContent")
 	url := endPointGetContent
 	if locale != "" {
 		url = fmt.Sprintf(endPointGetContent, locale)
 	}
 	var contents *ContentInfo
-	if err := cc.c.GetInto(url, &contents); err != nil {
+	if err := cc.c.GetIntoWithContext(ctx, url, &contents); err != nil {
 		logger.Debug(err)
 		fmt.Println(err)
 		return nil, err
@@ -1,11 +1,11 @@
 package val
 
 import (
+	"context"
 	"fmt"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // MatchClient provides methods for the match endpoints of the VALORANT API.
@@ -15,10 +15,16 @@ type MatchClient struct {
 
 // GetMatchByID returns information about a match using match id
 func (cc *MatchClient) GetMatchByID(matchID string) (*Match, error) {
+	return cc.GetMatchByIDWithContext(context.Background(), matchID)
+}
+
+// GetMatchByIDWithContext is like GetMatchByID but binds the request to the given context
+func (cc *MatchClient) GetMatchByIDWithContext(ctx context.Context, matchID string) (*Match, error) {
 	logger := cc.logger().WithField("method", "GetMatchByID")
 	url := endpointMatchByID
 	var match *Match
-	if err := cc.c.GetInto(fmt.Sprintf(url, matchID), &match); err != nil {
+	ctx = internal.WithEndpoint(ctx, url)
+	if err := cc.c.GetIntoWithContext(ctx, fmt.Sprintf(url, matchID), &match); err != nil {
 		logger.Debug(err)
 		fmt.Println(err)
 		return nil, err
@@ -28,10 +34,16 @@ func (cc *MatchClient) GetMatchByID(matchID string) (*Match, error) {
 
 // GetMatchListByPUUID returns match history as a list using player UUID
 func (cc *MatchClient) GetMatchListByPUUID(puuid string) (*MatchList, error) {
+	return cc.GetMatchListByPUUIDWithContext(context.Background(), puuid)
+}
+
+// GetMatchListByPUUIDWithContext is like GetMatchListByPUUID but binds the request to the given context
+func (cc *MatchClient) GetMatchListByPUUIDWithContext(ctx context.Context, puuid string) (*MatchList, error) {
 	logger := cc.logger().WithField("method", "GetMatchListByPUUID")
 	url := endpointMatchListByPUUID
 	var matchList *MatchList
-	if err := cc.c.GetInto(fmt.Sprintf(url, puuid), &matchList); err != nil {
+	ctx = internal.WithEndpoint(ctx, url)
+	if err := cc.c.GetIntoWithContext(ctx, fmt.Sprintf(url, puuid), &matchList); err != nil {
 		logger.Debug(err)
 		fmt.Println(err)
 		return nil, err
@@ -41,10 +53,16 @@ func (cc *MatchClient) GetMatchListByPUUID(puuid string) (*MatchList, error) {
 
 // GetRecentMatchesByQueue returns last match IDs for live regions and e-sports routing
 func (cc *MatchClient) GetRecentMatchesByQueue(queue string) (*RecentMatches, error) {
+	return cc.GetRecentMatchesByQueueWithContext(context.Background(), queue)
+}
+
+// GetRecentMatchesByQueueWithContext is like GetRecentMatchesByQueue but binds the request to the given context
+func (cc *MatchClient) GetRecentMatchesByQueueWithContext(ctx context.Context, queue string) (*RecentMatches, error) {
 	logger := cc.logger().WithField("method", "GetRecentMatchesByQueue")
 	url := endpointRecentMatchesByQueue
 	var recentMatches *RecentMatches
-	if err := cc.c.GetInto(fmt.Sprintf(url, queue), &recentMatches); err != nil {
+	ctx = internal.WithEndpoint(ctx, url)
+	if err := cc.c.GetIntoWithContext(ctx, fmt.Sprintf(url, queue), &recentMatches); err != nil {
 		logger.Debug(err)
 		fmt.Println(err)
 		return nil, err
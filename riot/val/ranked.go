@@ -1,11 +1,11 @@
 package val
 
 import (
+	"context"
 	"fmt"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // RankedClient provides methods for the ranked endpoints of the VALORANT API.
@@ -15,6 +15,13 @@ type RankedClient struct {
 
 // GetLeaderboardByActID returns leaderboard for the competitive queue by act ID
 func (cc *RankedClient) GetLeaderboardByActID(actID string, startIndex, size int32) (*Leaderboard, error) {
+	return cc.GetLeaderboardByActIDWithContext(context.Background(), actID, startIndex, size)
+}
+
+// GetLeaderboardByActIDWithContext is like GetLeaderboardByActID but binds the request to the given context
+func (cc *RankedClient) GetLeaderboardByActIDWithContext(
+	ctx context.Context, actID string, startIndex, size int32,
+) (*Leaderboard, error) {
 	logger := cc.logger().WithField("method", "GetLeaderboardByActID")
 	var leaderboard *Leaderboard
 	if startIndex < 0 {
@@ -23,7 +30,9 @@ func (cc *RankedClient) GetLeaderboardByActID(actID string, startIndex, size int
 	if size < 1 {
 		size = 200
 	}
-	if err := cc.c.GetInto(
+	ctx = internal.WithEndpoint(ctx, endpointGetLeaderboardByActID)
+	if err := cc.c.GetIntoWithContext(
+		ctx,
 		fmt.Sprintf(endpointGetLeaderboardByActID+"?size=%d&startIndex=%d", actID, size, startIndex), &leaderboard,
 	); err != nil {
 		logger.Debug(err)
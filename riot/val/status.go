@@ -1,9 +1,10 @@
 package val
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // StatusClient provides methods for the status endpoints of the VALORANT API.
@@ -13,9 +14,14 @@ type StatusClient struct {
 
 // GetPlatformData returns information about platform including maintenances and incidents
 func (cc *StatusClient) GetPlatformData() (*PlatformData, error) {
+	return cc.GetPlatformDataWithContext(context.Background())
+}
+
+// GetPlatformDataWithContext is like GetPlatformData but binds the request to the given context
+func (cc *StatusClient) GetPlatformDataWithContext(ctx context.Context) (*PlatformData, error) {
 	logger := cc.logger().WithField("method", "GetPlatformData")
 	var platformData *PlatformData
-	if err := cc.c.GetInto(endpointGetPlatformData, &platformData); err != nil {
+	if err := cc.c.GetIntoWithContext(ctx, endpointGetPlatformData, &platformData); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
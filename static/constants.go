@@ -7,4 +7,8 @@ const (
 	staticDataEndpointMaps      = staticDataBaseURL + "/maps.json"
 	staticDataEndpointGameModes = staticDataBaseURL + "/gameModes.json"
 	staticDataEndpointGameTypes = staticDataBaseURL + "/gameTypes.json"
+
+	communityDragonBaseURL = "https://raw.communitydragon.org/latest/plugins/rcp-be-lol-game-data/global/default/v1"
+	// Riot does not publish the Arena augments, so they are taken from CommunityDragon
+	communityDragonEndpointAugments = communityDragonBaseURL + "/cherry-augments.json"
 )
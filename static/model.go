@@ -32,3 +32,11 @@ type GameType struct {
 	Type        string `json:"gameType"`
 	Description string `json:"description"`
 }
+
+// Augment contains the name, rarity and icon of an Arena augment
+type Augment struct {
+	ID       int    `json:"id"`
+	Name     string `json:"nameTRA"`
+	Rarity   string `json:"rarity"`
+	IconPath string `json:"augmentSmallIconPath"`
+}
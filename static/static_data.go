@@ -7,32 +7,32 @@ import (
 	"net/http"
 	"sync"
 
-	"github.com/sirupsen/logrus"
-
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/log"
 )
 
 // Client provides access to static data provided by Riot
 // data is fetched on the first call to each method and cached for further calls
 type Client struct {
-	logger  logrus.FieldLogger
+	logger  log.FieldLogger
 	client  internal.Doer
 	mutexes map[string]*sync.RWMutex
 	cache   map[string]interface{}
 }
 
 // NewClient returns a new client
-func NewClient(doer internal.Doer, logger logrus.FieldLogger) *Client {
+func NewClient(doer internal.Doer, logger log.Logger) *Client {
 	mutexes := map[string]*sync.RWMutex{
 		"seasons":   {},
 		"queues":    {},
 		"maps":      {},
 		"gameModes": {},
 		"gameTypes": {},
+		"augments":  {},
 	}
 	return &Client{
-		logger:  logger,
+		logger:  log.Wrap(logger),
 		client:  doer,
 		mutexes: mutexes,
 		cache:   map[string]interface{}{},
@@ -199,6 +199,38 @@ func (c *Client) GetGameType(typ string) (GameType, error) {
 	return GameType{}, api.ErrNotFound
 }
 
+// GetAugments returns static data for Arena augments
+func (c *Client) GetAugments() ([]Augment, error) {
+	mu := c.mutexes["augments"]
+	unlock, toggle := internal.RWLockToggle(mu)
+	defer unlock()
+	augments, ok := c.cache["augments"].([]Augment)
+	if !ok {
+		toggle()
+		if err := c.getInto(communityDragonEndpointAugments, &augments); err != nil {
+			return nil, err
+		}
+		c.cache["augments"] = augments
+	}
+	res := make([]Augment, len(augments))
+	copy(res, augments)
+	return res, nil
+}
+
+// GetAugment returns the Arena augment for the specified id or an error if no augment for the id exists
+func (c *Client) GetAugment(id int) (Augment, error) {
+	augments, err := c.GetAugments()
+	if err != nil {
+		return Augment{}, err
+	}
+	for _, augment := range augments {
+		if augment.ID == id {
+			return augment, nil
+		}
+	}
+	return Augment{}, api.ErrNotFound
+}
+
 // ClearCaches clears caches for all methods
 func (c *Client) ClearCaches() {
 	c.cache = map[string]interface{}{}
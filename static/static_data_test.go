@@ -477,3 +477,88 @@ func TestClient_ClearCaches(t *testing.T) {
 	client := NewClient(http.DefaultClient, log.StandardLogger())
 	client.ClearCaches()
 }
+
+func TestClient_GetAugments(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []Augment
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: mock.NewJSONMockDoer([]Augment{{}}, 200),
+			want: []Augment{{}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c := NewClient(tt.doer, log.StandardLogger())
+				got, err := c.GetAugments()
+				assert.Equal(t, tt.wantErr, err)
+				if tt.wantErr == nil {
+					assert.Equal(t, tt.want, got)
+					got, err := c.GetAugments()
+					assert.Nil(t, err)
+					assert.Equal(t, tt.want, got)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_GetAugment(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		id      int
+		want    Augment
+		wantErr error
+	}
+	tests := []test{
+		{
+			name: "get response",
+			doer: mock.NewJSONMockDoer([]Augment{{ID: 1}}, 200),
+			id:   1,
+			want: Augment{ID: 1},
+		},
+		{
+			name:    "not found",
+			doer:    mock.NewJSONMockDoer([]Augment{}, 200),
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				client := NewClient(test.doer, log.StandardLogger())
+				got, err := client.GetAugment(test.id)
+				assert.Equal(t, test.wantErr, err)
+				assert.Equal(t, test.want, got)
+			},
+		)
+	}
+}